@@ -7,6 +7,7 @@ import (
 
 type awsConfig struct {
 	Regions               []string `cty:"regions"`
+	ExcludeRegions        []string `cty:"exclude_regions"`
 	Profile               *string  `cty:"profile"`
 	AccessKey             *string  `cty:"access_key"`
 	SecretKey             *string  `cty:"secret_key"`
@@ -14,7 +15,15 @@ type awsConfig struct {
 	MaxErrorRetryAttempts *int     `cty:"max_error_retry_attempts"`
 	MinErrorRetryDelay    *int     `cty:"min_error_retry_delay"`
 	IgnoreErrorCodes      []string `cty:"ignore_error_codes"`
+	MaxConcurrency        *int     `cty:"max_concurrency"`
 	EndpointUrl           *string  `cty:"endpoint_url"`
+	S3ForcePathStyle      *bool    `cty:"s3_force_path_style"`
+	InsecureSkipVerify    *bool    `cty:"insecure_skip_verify"`
+	S3BucketLocationTTL   *int     `cty:"s3_bucket_location_ttl"`
+	RoleArn               *string  `cty:"role_arn"`
+	ExternalId            *string  `cty:"external_id"`
+	SessionName           *string  `cty:"session_name"`
+	Duration              *int     `cty:"duration"`
 }
 
 var ConfigSchema = map[string]*schema.Attribute{
@@ -22,6 +31,10 @@ var ConfigSchema = map[string]*schema.Attribute{
 		Type: schema.TypeList,
 		Elem: &schema.Attribute{Type: schema.TypeString},
 	},
+	"exclude_regions": {
+		Type: schema.TypeList,
+		Elem: &schema.Attribute{Type: schema.TypeString},
+	},
 	"profile": {
 		Type: schema.TypeString,
 	},
@@ -44,9 +57,33 @@ var ConfigSchema = map[string]*schema.Attribute{
 	"min_error_retry_delay": {
 		Type: schema.TypeInt,
 	},
+	"max_concurrency": {
+		Type: schema.TypeInt,
+	},
 	"endpoint_url": {
 		Type: schema.TypeString,
 	},
+	"s3_force_path_style": {
+		Type: schema.TypeBool,
+	},
+	"insecure_skip_verify": {
+		Type: schema.TypeBool,
+	},
+	"s3_bucket_location_ttl": {
+		Type: schema.TypeInt,
+	},
+	"role_arn": {
+		Type: schema.TypeString,
+	},
+	"external_id": {
+		Type: schema.TypeString,
+	},
+	"session_name": {
+		Type: schema.TypeString,
+	},
+	"duration": {
+		Type: schema.TypeInt,
+	},
 }
 
 func ConfigInstance() interface{} {
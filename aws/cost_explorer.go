@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/costexplorer"
 	"github.com/golang/protobuf/ptypes/timestamp"
 
@@ -11,6 +12,10 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
 )
 
+// Cost Explorer charges for each API request, so responses are cached per
+// connection for this long
+const costExplorerCacheTTL = 1 * time.Hour
+
 // AllCostMetrics is a constant returning all the cost metrics
 func AllCostMetrics() []string {
 	return []string{
@@ -125,12 +130,26 @@ func costExplorerColumns(columns []*plugin.Column) []*plugin.Column {
 	return append(columns, costExplorerColumnDefs...)
 }
 
+// costExplorerPeriodKeyColumns are the optional period quals that are pushed
+// down into the TimePeriod of the request, see getCETimePeriod
+func costExplorerPeriodKeyColumns() plugin.KeyColumnSlice {
+	return plugin.KeyColumnSlice{
+		{Name: "period_start", Operators: []string{">", ">=", "="}, Require: plugin.Optional},
+		{Name: "period_end", Operators: []string{"<", "<=", "="}, Require: plugin.Optional},
+	}
+}
+
 //// LIST FUNCTION
 
 func streamCostAndUsage(ctx context.Context, d *plugin.QueryData, params *costexplorer.GetCostAndUsageInput) (interface{}, error) {
 	logger := plugin.Logger(ctx)
 	logger.Trace("streamCostAndUsage")
 
+	// The period quals may leave nothing to request, the end of the period is exclusive
+	if *params.TimePeriod.Start >= *params.TimePeriod.End {
+		return nil, nil
+	}
+
 	// Create session
 	svc, err := CostExplorerService(ctx, d)
 	if err != nil {
@@ -138,10 +157,18 @@ func streamCostAndUsage(ctx context.Context, d *plugin.QueryData, params *costex
 	}
 	// List call
 	for {
-		output, err := svc.GetCostAndUsage(params)
-		if err != nil {
-			logger.Error("streamCostAndUsage", "err", err)
-			return nil, err
+		var output *costexplorer.GetCostAndUsageOutput
+		cacheKey := "costexplorer-" + params.String()
+		if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+			output = cachedData.(*costexplorer.GetCostAndUsageOutput)
+		} else {
+			logger.Info("streamCostAndUsage", "GetCostAndUsage request, charged at $0.01 per request", "time_period", params.TimePeriod.String())
+			output, err = svc.GetCostAndUsage(params)
+			if err != nil {
+				logger.Error("streamCostAndUsage", "err", err)
+				return nil, err
+			}
+			d.ConnectionManager.Cache.SetWithTTL(cacheKey, output, costExplorerCacheTTL)
 		}
 
 		// stream the results...
@@ -275,6 +302,41 @@ func getCEStartDateForGranularity(granularity string) time.Time {
 	return time.Now().AddDate(0, 0, -13)
 }
 
+// getCETimePeriod returns the time period to request for the granularity,
+// narrowed by any period_start and period_end quals. The start of the period
+// is inclusive and the end is exclusive, so a row ending on the requested end
+// date is still returned.
+func getCETimePeriod(granularity string, d *plugin.QueryData) *costexplorer.DateInterval {
+	timeFormat := "2006-01-02"
+	if granularity == "HOURLY" {
+		timeFormat = "2006-01-02T15:04:05Z"
+	}
+	startTime := getCEStartDateForGranularity(granularity)
+	endTime := time.Now()
+
+	if d.Quals["period_start"] != nil {
+		for _, q := range d.Quals["period_start"].Quals {
+			switch q.Operator {
+			case ">", ">=", "=":
+				startTime = q.Value.GetTimestampValue().AsTime()
+			}
+		}
+	}
+	if d.Quals["period_end"] != nil {
+		for _, q := range d.Quals["period_end"].Quals {
+			switch q.Operator {
+			case "<", "<=", "=":
+				endTime = q.Value.GetTimestampValue().AsTime()
+			}
+		}
+	}
+
+	return &costexplorer.DateInterval{
+		Start: aws.String(startTime.Format(timeFormat)),
+		End:   aws.String(endTime.Format(timeFormat)),
+	}
+}
+
 type CEQuals struct {
 	// Quals stuff
 	SearchStartTime *timestamp.Timestamp
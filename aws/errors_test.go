@@ -0,0 +1,35 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+func TestShouldIgnoreErrorPluginDefault(t *testing.T) {
+	d := &plugin.QueryData{
+		Connection: &plugin.Connection{
+			Config: awsConfig{IgnoreErrorCodes: []string{"AccessDenied*", "UnauthorizedOperation"}},
+		},
+	}
+	shouldIgnore := shouldIgnoreErrorPluginDefault()
+
+	tests := []struct {
+		err      error
+		expected bool
+	}{
+		{awserr.New("AccessDenied", "", nil), true},
+		{awserr.New("AccessDeniedException", "", nil), true},
+		{awserr.New("UnauthorizedOperation", "", nil), true},
+		{awserr.New("Throttling", "", nil), false},
+		{errors.New("AccessDenied"), false},
+	}
+	for _, test := range tests {
+		if actual := shouldIgnore(context.Background(), d, nil, test.err); actual != test.expected {
+			t.Errorf("expected %v for %v, got %v", test.expected, test.err, actual)
+		}
+	}
+}
@@ -56,12 +56,14 @@ func BuildRegionList(ctx context.Context, connection *plugin.Connection) []map[s
 	if len(allRegions) > 0 {
 		uniqueRegions := unique(allRegions)
 
-		if len(getInvalidRegions(uniqueRegions)) > 0 {
+		// Custom endpoints may use region names unknown to AWS
+		if len(getInvalidRegions(uniqueRegions)) > 0 && getEndpointUrl(awsConfig) == "" {
 			panic("\n\nConnection config has invalid regions: " + strings.Join(getInvalidRegions(uniqueRegions), ", "))
 		}
 
-		// Remove inactive regions from the list
+		// Remove inactive and excluded regions from the list
 		finalRegions := helpers.StringSliceDiff(uniqueRegions, regionData["NotOptedRegions"])
+		finalRegions = excludeRegions(finalRegions, awsConfig.ExcludeRegions)
 
 		matrix := make([]map[string]interface{}, len(finalRegions))
 		for i, region := range finalRegions {
@@ -82,6 +84,29 @@ func BuildRegionList(ctx context.Context, connection *plugin.Connection) []map[s
 	return matrix
 }
 
+// excludeRegions removes the regions matching any of the given patterns, which
+// support the same wildcards as the regions argument
+func excludeRegions(regions []string, excludePatterns []string) []string {
+	if len(excludePatterns) == 0 {
+		return regions
+	}
+
+	var filteredRegions []string
+	for _, region := range regions {
+		excluded := false
+		for _, pattern := range excludePatterns {
+			if ok, _ := path.Match(pattern, region); ok {
+				excluded = true
+				break
+			}
+		}
+		if !excluded {
+			filteredRegions = append(filteredRegions, region)
+		}
+	}
+	return filteredRegions
+}
+
 func getInvalidRegions(regions []string) []string {
 	awsRegions := []string{
 		"af-south-1", "ap-east-1", "ap-northeast-1", "ap-northeast-2", "ap-northeast-3", "ap-south-1", "ap-southeast-1", "ap-southeast-2", "ap-southeast-3", "ca-central-1", "eu-central-1", "eu-north-1", "eu-south-1", "eu-west-1", "eu-west-2", "eu-west-3", "me-south-1", "sa-east-1", "us-east-1", "us-east-2", "us-west-1", "us-west-2", "us-gov-east-1", "us-gov-west-1", "cn-north-1", "cn-northwest-1"}
@@ -104,9 +129,20 @@ func BuildWafRegionList(ctx context.Context, connection *plugin.Connection) []ma
 		regionMatrix = BuildRegionList(ctx, connection)
 	}
 
-	matrix := make([]map[string]interface{}, 1, len(regionMatrix)+1)
-	matrix[0] = map[string]interface{}{matrixKeyRegion: "global"}
-	matrix = append(matrix, regionMatrix...)
+	regions := []string{"global"}
+	for _, item := range regionMatrix {
+		regions = append(regions, item[matrixKeyRegion].(string))
+	}
+
+	// The region matrix falls back to the default region without applying the
+	// excluded regions, and "global" may be excluded too
+	awsConfig := GetConfig(connection)
+	regions = excludeRegions(regions, awsConfig.ExcludeRegions)
+
+	matrix := make([]map[string]interface{}, len(regions))
+	for i, region := range regions {
+		matrix[i] = map[string]interface{}{matrixKeyRegion: region}
+	}
 
 	return matrix
 }
@@ -246,12 +282,14 @@ func BuildServiceQuotasServicesRegionList(ctx context.Context, connection *plugi
 	if len(allRegions) > 0 {
 		uniqueRegions := unique(allRegions)
 
-		if len(getInvalidRegions(uniqueRegions)) > 0 {
+		// Custom endpoints may use region names unknown to AWS
+		if len(getInvalidRegions(uniqueRegions)) > 0 && getEndpointUrl(awsConfig) == "" {
 			panic("\n\nConnection config has invalid regions: " + strings.Join(getInvalidRegions(uniqueRegions), ", "))
 		}
 
-		// Remove inactive regions from the list
+		// Remove inactive and excluded regions from the list
 		finalRegions := helpers.StringSliceDiff(uniqueRegions, regionData["NotOptedRegions"])
+		finalRegions = excludeRegions(finalRegions, awsConfig.ExcludeRegions)
 
 		matrix := make([]map[string]interface{}, len(finalRegions)*len(services))
 		for i, region := range finalRegions {
@@ -44,7 +44,9 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 		},
 		TableMap: map[string]*plugin.Table{
 			"aws_accessanalyzer_analyzer":                                  tableAwsAccessAnalyzer(ctx),
+			"aws_accessanalyzer_finding":                                   tableAwsAccessAnalyzerFinding(ctx),
 			"aws_account":                                                  tableAwsAccount(ctx),
+			"aws_account_alternate_contact":                                tableAwsAccountAlternateContact(ctx),
 			"aws_acm_certificate":                                          tableAwsAcmCertificate(ctx),
 			"aws_api_gateway_api_key":                                      tableAwsAPIGatewayAPIKey(ctx),
 			"aws_api_gateway_authorizer":                                   tableAwsAPIGatewayAuthorizer(ctx),
@@ -54,6 +56,7 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_api_gatewayv2_api":                                        tableAwsAPIGatewayV2Api(ctx),
 			"aws_api_gatewayv2_domain_name":                                tableAwsAPIGatewayV2DomainName(ctx),
 			"aws_api_gatewayv2_integration":                                tableAwsAPIGatewayV2Integration(ctx),
+			"aws_api_gatewayv2_route":                                      tableAwsAPIGatewayV2Route(ctx),
 			"aws_api_gatewayv2_stage":                                      tableAwsAPIGatewayV2Stage(ctx),
 			"aws_appautoscaling_target":                                    tableAwsAppAutoScalingTarget(ctx),
 			"aws_auditmanager_assessment":                                  tableAwsAuditManagerAssessment(ctx),
@@ -67,12 +70,17 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_backup_recovery_point":                                    tableAwsBackupRecoveryPoint(ctx),
 			"aws_backup_selection":                                         tableAwsBackupSelection(ctx),
 			"aws_backup_vault":                                             tableAwsBackupVault(ctx),
+			"aws_budgets_budget":                                           tableAwsBudgetsBudget(ctx),
 			"aws_cloudcontrol_resource":                                    tableAwsCloudControlResource(ctx),
 			"aws_cloudformation_stack":                                     tableAwsCloudFormationStack(ctx),
+			"aws_cloudformation_stack_resource":                            tableAwsCloudFormationStackResource(ctx),
+			"aws_cloudformation_stack_set":                                 tableAwsCloudFormationStackSet(ctx),
 			"aws_cloudfront_cache_policy":                                  tableAwsCloudFrontCachePolicy(ctx),
 			"aws_cloudfront_distribution":                                  tableAwsCloudFrontDistribution(ctx),
+			"aws_cloudfront_function":                                      tableAwsCloudFrontFunction(ctx),
 			"aws_cloudfront_origin_access_identity":                        tableAwsCloudFrontOriginAccessIdentity(ctx),
 			"aws_cloudfront_origin_request_policy":                         tableAwsCloudFrontOriginRequestPolicy(ctx),
+			"aws_cloudfront_response_headers_policy":                       tableAwsCloudFrontResponseHeadersPolicy(ctx),
 			"aws_cloudtrail_trail":                                         tableAwsCloudtrailTrail(ctx),
 			"aws_cloudtrail_trail_event":                                   tableAwsCloudtrailTrailEvent(ctx),
 			"aws_cloudwatch_alarm":                                         tableAwsCloudWatchAlarm(ctx),
@@ -82,13 +90,19 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_cloudwatch_log_resource_policy":                           tableAwsCloudwatchLogResourcePolicy(ctx),
 			"aws_cloudwatch_log_stream":                                    tableAwsCloudwatchLogStream(ctx),
 			"aws_cloudwatch_metric":                                        tableAwsCloudWatchMetric(ctx),
+			"aws_cloudwatch_metric_statistic_data_point":                   tableAwsCloudWatchMetricStatisticDataPoint(ctx),
+			"aws_codeartifact_domain":                                      tableAwsCodeArtifactDomain(ctx),
+			"aws_codeartifact_repository":                                  tableAwsCodeArtifactRepository(ctx),
 			"aws_codebuild_project":                                        tableAwsCodeBuildProject(ctx),
 			"aws_codebuild_source_credential":                              tableAwsCodeBuildSourceCredential(ctx),
 			"aws_codecommit_repository":                                    tableAwsCodeCommitRepository(ctx),
+			"aws_codedeploy_app":                                           tableAwsCodeDeployApp(ctx),
 			"aws_codepipeline_pipeline":                                    tableAwsCodepipelinePipeline(ctx),
 			"aws_config_aggregate_authorization":                           tableAwsConfigAggregateAuthorization(ctx),
+			"aws_config_configuration_aggregator":                          tableAwsConfigConfigurationAggregator(ctx),
 			"aws_config_configuration_recorder":                            tableAwsConfigConfigurationRecorder(ctx),
 			"aws_config_conformance_pack":                                  tableAwsConfigConformancePack(ctx),
+			"aws_config_retention_configuration":                           tableAwsConfigRetentionConfiguration(ctx),
 			"aws_config_rule":                                              tableAwsConfigRule(ctx),
 			"aws_cost_by_account_daily":                                    tableAwsCostByLinkedAccountDaily(ctx),
 			"aws_cost_by_account_monthly":                                  tableAwsCostByLinkedAccountMonthly(ctx),
@@ -102,14 +116,21 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_cost_forecast_monthly":                                    tableAwsCostForecastMonthly(ctx),
 			"aws_cost_usage":                                               tableAwsCostAndUsage(ctx),
 			"aws_dax_cluster":                                              tableAwsDaxCluster(ctx),
+			"aws_directconnect_connection":                                 tableAwsDirectConnectConnection(ctx),
+			"aws_directconnect_gateway":                                    tableAwsDirectConnectGateway(ctx),
+			"aws_directconnect_virtual_interface":                          tableAwsDirectConnectVirtualInterface(ctx),
 			"aws_directory_service_directory":                              tableAwsDirectoryServiceDirectory(ctx),
 			"aws_dlm_lifecycle_policy":                                     tableAwsDLMLifecyclePolicy(ctx),
+			"aws_dms_endpoint":                                             tableAwsDmsEndpoint(ctx),
 			"aws_dms_replication_instance":                                 tableAwsDmsReplicationInstance(ctx),
+			"aws_docdb_cluster":                                            tableAwsDocDBCluster(ctx),
+			"aws_docdb_cluster_instance":                                   tableAwsDocDBClusterInstance(ctx),
 			"aws_dynamodb_backup":                                          tableAwsDynamoDBBackup(ctx),
 			"aws_dynamodb_global_table":                                    tableAwsDynamoDBGlobalTable(ctx),
 			"aws_dynamodb_metric_account_provisioned_read_capacity_util":   tableAwsDynamoDBMetricAccountProvisionedReadCapacityUtilization(ctx),
 			"aws_dynamodb_metric_account_provisioned_write_capacity_util":  tableAwsDynamoDBMetricAccountProvisionedWriteCapacityUtilization(ctx),
 			"aws_dynamodb_table":                                           tableAwsDynamoDBTable(ctx),
+			"aws_dynamodb_table_export":                                    tableAwsDynamoDBTableExport(ctx),
 			"aws_ebs_snapshot":                                             tableAwsEBSSnapshot(ctx),
 			"aws_ebs_volume":                                               tableAwsEBSVolume(ctx),
 			"aws_ebs_volume_metric_read_ops":                               tableAwsEbsVolumeMetricReadOps(ctx),
@@ -135,6 +156,8 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_ec2_instance_type":                                        tableAwsInstanceType(ctx),
 			"aws_ec2_key_pair":                                             tableAwsEc2KeyPair(ctx),
 			"aws_ec2_launch_configuration":                                 tableAwsEc2LaunchConfiguration(ctx),
+			"aws_ec2_launch_template":                                      tableAwsEc2LaunchTemplate(ctx),
+			"aws_ec2_launch_template_version":                              tableAwsEc2LaunchTemplateVersion(ctx),
 			"aws_ec2_load_balancer_listener":                               tableAwsEc2ApplicationLoadBalancerListener(ctx),
 			"aws_ec2_managed_prefix_list":                                  tableAwsEc2ManagedPrefixList(ctx),
 			"aws_ec2_network_interface":                                    tableAwsEc2NetworkInterface(ctx),
@@ -143,12 +166,16 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_ec2_network_load_balancer_metric_net_flow_count_daily":    tableAwsEc2NetworkLoadBalancerMetricNetFlowCountDaily(ctx),
 			"aws_ec2_regional_settings":                                    tableAwsEc2RegionalSettings(ctx),
 			"aws_ec2_reserved_instance":                                    tableAwsEc2ReservedInstance(ctx),
+			"aws_ec2_savings_plan_utilization":                             tableAwsEc2SavingsPlanUtilization(ctx),
+			"aws_ec2_spot_instance_request":                                tableAwsEc2SpotInstanceRequest(ctx),
+			"aws_ec2_spot_price":                                           tableAwsEc2SpotPrice(ctx),
 			"aws_ec2_ssl_policy":                                           tableAwsEc2SslPolicy(ctx),
 			"aws_ec2_target_group":                                         tableAwsEc2TargetGroup(ctx),
 			"aws_ec2_transit_gateway":                                      tableAwsEc2TransitGateway(ctx),
 			"aws_ec2_transit_gateway_route":                                tableAwsEc2TransitGatewayRoute(ctx),
 			"aws_ec2_transit_gateway_route_table":                          tableAwsEc2TransitGatewayRouteTable(ctx),
 			"aws_ec2_transit_gateway_vpc_attachment":                       tableAwsEc2TransitGatewayVpcAttachment(ctx),
+			"aws_ecr_image":                                                tableAwsEcrImage(ctx),
 			"aws_ecr_repository":                                           tableAwsEcrRepository(ctx),
 			"aws_ecrpublic_repository":                                     tableAwsEcrpublicRepository(ctx),
 			"aws_ecs_cluster":                                              tableAwsEcsCluster(ctx),
@@ -165,7 +192,9 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_eks_addon":                                                tableAwsEksAddon(ctx),
 			"aws_eks_addon_version":                                        tableAwsEksAddonVersion(ctx),
 			"aws_eks_cluster":                                              tableAwsEksCluster(ctx),
+			"aws_eks_fargate_profile":                                      tableAwsEksFargateProfile(ctx),
 			"aws_eks_identity_provider_config":                             tableAwsEksIdentityProviderConfig(ctx),
+			"aws_eks_node_group":                                           tableAwsEksNodeGroup(ctx),
 			"aws_elastic_beanstalk_application":                            tableAwsElasticBeanstalkApplication(ctx),
 			"aws_elastic_beanstalk_environment":                            tableAwsElasticBeanstalkEnvironment(ctx),
 			"aws_elasticache_cluster":                                      tableAwsElastiCacheCluster(ctx),
@@ -180,22 +209,33 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_elasticache_replication_group":                            tableAwsElastiCacheReplicationGroup(ctx),
 			"aws_elasticache_subnet_group":                                 tableAwsElastiCacheSubnetGroup(ctx),
 			"aws_elasticsearch_domain":                                     tableAwsElasticsearchDomain(ctx),
+			"aws_emr_block_public_access_configuration":                    tableAwsEmrBlockPublicAccessConfiguration(ctx),
 			"aws_emr_cluster":                                              tableAwsEmrCluster(ctx),
 			"aws_emr_cluster_metric_is_idle":                               tableAwsEmrClusterMetricIsIdle(ctx),
+			"aws_emr_instance":                                             tableAwsEmrInstance(ctx),
 			"aws_emr_instance_group":                                       tableAwsEmrInstanceGroup(ctx),
 			"aws_eventbridge_bus":                                          tableAwsEventBridgeBus(ctx),
 			"aws_eventbridge_rule":                                         tableAwsEventBridgeRule(ctx),
+			"aws_fms_policy":                                               tableAwsFMSPolicy(ctx),
 			"aws_fsx_file_system":                                          tableAwsFsxFileSystem(ctx),
 			"aws_glacier_vault":                                            tableAwsGlacierVault(ctx),
+			"aws_globalaccelerator_accelerator":                            tableAwsGlobalAcceleratorAccelerator(ctx),
+			"aws_globalaccelerator_endpoint_group":                         tableAwsGlobalAcceleratorEndpointGroup(ctx),
+			"aws_globalaccelerator_listener":                               tableAwsGlobalAcceleratorListener(ctx),
 			"aws_glue_catalog_database":                                    tableAwsGlueCatalogDatabase(ctx),
 			"aws_glue_catalog_table":                                       tableAwsGlueCatalogTable(ctx),
+			"aws_glue_connection":                                          tableAwsGlueConnection(ctx),
 			"aws_glue_crawler":                                             tableAwsGlueCrawler(ctx),
+			"aws_glue_data_catalog_encryption_settings":                    tableAwsGlueDataCatalogEncryptionSettings(ctx),
 			"aws_glue_dev_endpoint":                                        tableAwsGlueDevEndpoint(ctx),
+			"aws_glue_job":                                                 tableAwsGlueJob(ctx),
+			"aws_glue_security_configuration":                              tableAwsGlueSecurityConfiguration(ctx),
 			"aws_guardduty_detector":                                       tableAwsGuardDutyDetector(ctx),
 			"aws_guardduty_filter":                                         tableAwsGuardDutyFilter(ctx),
 			"aws_guardduty_finding":                                        tableAwsGuardDutyFinding(ctx),
 			"aws_guardduty_ipset":                                          tableAwsGuardDutyIPSet(ctx),
 			"aws_guardduty_member":                                         tableAwsGuardDutyMember(ctx),
+			"aws_guardduty_organization_configuration":                     tableAwsGuardDutyOrganizationConfiguration(ctx),
 			"aws_guardduty_publishing_destination":                         tableAwsGuardDutyPublishingDestination(ctx),
 			"aws_guardduty_threat_intel_set":                               tableAwsGuardDutyThreatIntelSet(ctx),
 			"aws_iam_access_advisor":                                       tableAwsIamAccessAdvisor(ctx),
@@ -205,10 +245,12 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_iam_action":                                               tableAwsIamAction(ctx),
 			"aws_iam_credential_report":                                    tableAwsIamCredentialReport(ctx),
 			"aws_iam_group":                                                tableAwsIamGroup(ctx),
+			"aws_iam_open_id_connect_provider":                             tableAwsIamOpenIdConnectProvider(ctx),
 			"aws_iam_policy":                                               tableAwsIamPolicy(ctx),
 			"aws_iam_policy_attachment":                                    tableAwsIamPolicyAttachment(ctx),
 			"aws_iam_policy_simulator":                                     tableAwsIamPolicySimulator(ctx),
 			"aws_iam_role":                                                 tableAwsIamRole(ctx),
+			"aws_iam_saml_provider":                                        tableAwsIamSamlProvider(ctx),
 			"aws_iam_server_certificate":                                   tableAwsIamServerCertificate(ctx),
 			"aws_iam_user":                                                 tableAwsIamUser(ctx),
 			"aws_iam_virtual_mfa_device":                                   tableAwsIamVirtualMfaDevice(ctx),
@@ -226,6 +268,7 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_kinesisanalyticsv2_application":                           tableAwsKinesisAnalyticsV2Application(ctx),
 			"aws_kms_key":                                                  tableAwsKmsKey(ctx),
 			"aws_lambda_alias":                                             tableAwsLambdaAlias(ctx),
+			"aws_lambda_event_source_mapping":                              tableAwsLambdaEventSourceMapping(ctx),
 			"aws_lambda_function":                                          tableAwsLambdaFunction(ctx),
 			"aws_lambda_function_metric_duration_daily":                    tableAwsLambdaFunctionMetricDurationDaily(ctx),
 			"aws_lambda_function_metric_errors_daily":                      tableAwsLambdaFunctionMetricErrorsDaily(ctx),
@@ -233,15 +276,26 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_lambda_layer":                                             tableAwsLambdaLayer(ctx),
 			"aws_lambda_layer_version":                                     tableAwsLambdaLayerVersion(ctx),
 			"aws_lambda_version":                                           tableAwsLambdaVersion(ctx),
+			"aws_lightsail_bucket":                                         tableAwsLightsailBucket(ctx),
+			"aws_lightsail_database":                                       tableAwsLightsailDatabase(ctx),
+			"aws_lightsail_instance":                                       tableAwsLightsailInstance(ctx),
 			"aws_macie2_classification_job":                                tableAwsMacie2ClassificationJob(ctx),
+			"aws_macie2_finding":                                           tableAwsMacie2Finding(ctx),
 			"aws_media_store_container":                                    tableAwsMediaStoreContainer(ctx),
+			"aws_mq_broker":                                                tableAwsMQBroker(ctx),
+			"aws_msk_cluster":                                              tableAwsMSKCluster(ctx),
 			"aws_neptune_db_cluster":                                       tableAwsNeptuneDBCluster(ctx),
+			"aws_networkfirewall_firewall":                                 tableAwsNetworkFirewallFirewall(ctx),
+			"aws_networkfirewall_firewall_policy":                          tableAwsNetworkFirewallFirewallPolicy(ctx),
 			"aws_networkfirewall_rule_group":                               tableAwsNetworkFirewallRuleGroup(ctx),
 			"aws_opensearch_domain":                                        tableAwsOpenSearchDomain(ctx),
 			"aws_organizations_account":                                    tableAwsOrganizationsAccount(ctx),
+			"aws_organizations_organizational_unit":                        tableAwsOrganizationsOrganizationalUnit(ctx),
+			"aws_organizations_policy":                                     tableAwsOrganizationsPolicy(ctx),
 			"aws_pinpoint_app":                                             tableAwsPinpointApp(ctx),
 			"aws_ram_principal_association":                                tableAwsRAMPrincipalAssociation(ctx),
 			"aws_ram_resource_association":                                 tableAwsRAMResourceAssociation(ctx),
+			"aws_ram_resource_share":                                       tableAwsRAMResourceShare(ctx),
 			"aws_rds_db_cluster":                                           tableAwsRDSDBCluster(ctx),
 			"aws_rds_db_cluster_parameter_group":                           tableAwsRDSDBClusterParameterGroup(ctx),
 			"aws_rds_db_cluster_snapshot":                                  tableAwsRDSDBClusterSnapshot(ctx),
@@ -261,8 +315,10 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_rds_db_instance_metric_write_iops_hourly":                 tableAwsRdsInstanceMetricWriteIopsHourly(ctx),
 			"aws_rds_db_option_group":                                      tableAwsRDSDBOptionGroup(ctx),
 			"aws_rds_db_parameter_group":                                   tableAwsRDSDBParameterGroup(ctx),
+			"aws_rds_db_proxy":                                             tableAwsRDSDBProxy(ctx),
 			"aws_rds_db_snapshot":                                          tableAwsRDSDBSnapshot(ctx),
 			"aws_rds_db_subnet_group":                                      tableAwsRDSDBSubnetGroup(ctx),
+			"aws_rds_reserved_db_instance":                                 tableAwsRDSReservedDBInstance(ctx),
 			"aws_redshift_cluster":                                         tableAwsRedshiftCluster(ctx),
 			"aws_redshift_cluster_metric_cpu_utilization_daily":            tableAwsRedshiftClusterMetricCpuUtilizationDaily(ctx),
 			"aws_redshift_event_subscription":                              tableAwsRedshiftEventSubscription(ctx),
@@ -281,12 +337,16 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_s3_access_point":                                          tableAwsS3AccessPoint(ctx),
 			"aws_s3_account_settings":                                      tableAwsS3AccountSettings(ctx),
 			"aws_s3_bucket":                                                tableAwsS3Bucket(ctx),
+			"aws_s3_object":                                                tableAwsS3Object(ctx),
+			"aws_s3_object_version":                                        tableAwsS3ObjectVersion(ctx),
 			"aws_sagemaker_app":                                            tableAwsSageMakerApp(ctx),
 			"aws_sagemaker_domain":                                         tableAwsSageMakerDomain(ctx),
+			"aws_sagemaker_endpoint":                                       tableAwsSageMakerEndpoint(ctx),
 			"aws_sagemaker_endpoint_configuration":                         tableAwsSageMakerEndpointConfiguration(ctx),
 			"aws_sagemaker_model":                                          tableAwsSageMakerModel(ctx),
 			"aws_sagemaker_notebook_instance":                              tableAwsSageMakerNotebookInstance(ctx),
 			"aws_sagemaker_training_job":                                   tableAwsSageMakerTrainingJob(ctx),
+			"aws_savingsplan":                                              tableAwsSavingsPlan(ctx),
 			"aws_secretsmanager_secret":                                    tableAwsSecretsManagerSecret(ctx),
 			"aws_securityhub_action_target":                                tableAwsSecurityHubActionTarget(ctx),
 			"aws_securityhub_finding":                                      tableAwsSecurityHubFinding(ctx),
@@ -294,6 +354,7 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_securityhub_hub":                                          tableAwsSecurityHub(ctx),
 			"aws_securityhub_insight":                                      tableAwsSecurityHubInsight(ctx),
 			"aws_securityhub_member":                                       tableAwsSecurityHubMember(ctx),
+			"aws_securityhub_organization_configuration":                   tableAwsSecurityHubOrganizationConfiguration(ctx),
 			"aws_securityhub_product":                                      tableAwsSecurityhubProduct(ctx),
 			"aws_securityhub_standards_control":                            tableAwsSecurityHubStandardsControl(ctx),
 			"aws_securityhub_standards_subscription":                       tableAwsSecurityHubStandardsSubscription(ctx),
@@ -301,25 +362,33 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_servicequotas_default_service_quota":                      tableAwsServiceQuotasDefaultServiceQuota(ctx),
 			"aws_servicequotas_service_quota":                              tableAwsServiceQuotasServiceQuota(ctx),
 			"aws_servicequotas_service_quota_change_request":               tableAwsServiceQuotasServiceQuotaChangeRequest(ctx),
+			"aws_ses_configuration_set":                                    tableAwsSESConfigurationSet(ctx),
 			"aws_ses_email_identity":                                       tableAwsSESEmailIdentity(ctx),
 			"aws_sfn_state_machine":                                        tableAwsStepFunctionsStateMachine(ctx),
 			"aws_sfn_state_machine_execution":                              tableAwsStepFunctionsStateMachineExecution(ctx),
 			"aws_sfn_state_machine_execution_history":                      tableAwsStepFunctionsStateMachineExecutionHistory(ctx),
+			"aws_shield_protection":                                        tableAwsShieldProtection(ctx),
+			"aws_shield_subscription":                                      tableAwsShieldSubscription(ctx),
 			"aws_sns_topic":                                                tableAwsSnsTopic(ctx),
 			"aws_sns_topic_subscription":                                   tableAwsSnsTopicSubscription(ctx),
 			"aws_sqs_queue":                                                tableAwsSqsQueue(ctx),
 			"aws_ssm_association":                                          tableAwsSSMAssociation(ctx),
 			"aws_ssm_document":                                             tableAwsSSMDocument(ctx),
 			"aws_ssm_inventory":                                            tableAwsSSMInventory(ctx),
+			"aws_ssm_inventory_entry":                                      tableAwsSSMInventoryEntry(ctx),
 			"aws_ssm_maintenance_window":                                   tableAwsSSMMaintenanceWindow(ctx),
 			"aws_ssm_managed_instance":                                     tableAwsSSMManagedInstance(ctx),
 			"aws_ssm_managed_instance_compliance":                          tableAwsSSMManagedInstanceCompliance(ctx),
+			"aws_ssm_managed_instance_patch_state":                         tableAwsSSMManagedInstancePatchState(ctx),
 			"aws_ssm_parameter":                                            tableAwsSSMParameter(ctx),
 			"aws_ssm_patch_baseline":                                       tableAwsSSMPatchBaseline(ctx),
 			"aws_ssoadmin_instance":                                        tableAwsSsoAdminInstance(ctx),
 			"aws_ssoadmin_managed_policy_attachment":                       tableAwsSsoAdminManagedPolicyAttachment(ctx),
 			"aws_ssoadmin_permission_set":                                  tableAwsSsoAdminPermissionSet(ctx),
+			"aws_synthetics_canary":                                        tableAwsSyntheticsCanary(ctx),
 			"aws_tagging_resource":                                         tableAwsTaggingResource(ctx),
+			"aws_trusted_advisor_check_result":                             tableAwsTrustedAdvisorCheckResult(ctx),
+			"aws_trusted_advisor_check_summary":                            tableAwsTrustedAdvisorCheckSummary(ctx),
 			"aws_vpc":                                                      tableAwsVpc(ctx),
 			"aws_vpc_customer_gateway":                                     tableAwsVpcCustomerGateway(ctx),
 			"aws_vpc_dhcp_options":                                         tableAwsVpcDhcpOptions(ctx),
@@ -348,7 +417,10 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_wafv2_rule_group":                                         tableAwsWafv2RuleGroup(ctx),
 			"aws_wafv2_web_acl":                                            tableAwsWafv2WebAcl(ctx),
 			"aws_wellarchitected_workload":                                 tableAwsWellArchitectedWorkload(ctx),
+			"aws_workspaces_directory":                                     tableAwsWorkspacesDirectory(ctx),
 			"aws_workspaces_workspace":                                     tableAwsWorkspace(ctx),
+			"aws_xray_group":                                               tableAwsXRayGroup(ctx),
+			"aws_xray_sampling_rule":                                       tableAwsXRaySamplingRule(ctx),
 		},
 	}
 
@@ -2,23 +2,28 @@ package aws
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"math"
 	"math/rand"
+	"net/http"
 	"os"
 	"path"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go/service/account"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
@@ -26,21 +31,26 @@ import (
 	"github.com/aws/aws-sdk-go/service/auditmanager"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/aws/aws-sdk-go/service/budgets"
 	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/aws/aws-sdk-go/service/cloudtrail"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/codeartifact"
 	"github.com/aws/aws-sdk-go/service/codebuild"
 	"github.com/aws/aws-sdk-go/service/codecommit"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
 	"github.com/aws/aws-sdk-go/service/codepipeline"
 	"github.com/aws/aws-sdk-go/service/configservice"
 	"github.com/aws/aws-sdk-go/service/costexplorer"
 	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
 	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/aws/aws-sdk-go/service/directconnect"
 	"github.com/aws/aws-sdk-go/service/directoryservice"
 	"github.com/aws/aws-sdk-go/service/dlm"
+	"github.com/aws/aws-sdk-go/service/docdb"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ecr"
@@ -56,20 +66,25 @@ import (
 	"github.com/aws/aws-sdk-go/service/emr"
 	"github.com/aws/aws-sdk-go/service/eventbridge"
 	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/fms"
 	"github.com/aws/aws-sdk-go/service/fsx"
 	"github.com/aws/aws-sdk-go/service/glacier"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/aws/aws-sdk-go/service/guardduty"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/identitystore"
 	"github.com/aws/aws-sdk-go/service/inspector"
+	"github.com/aws/aws-sdk-go/service/kafka"
 	"github.com/aws/aws-sdk-go/service/kinesis"
 	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
 	"github.com/aws/aws-sdk-go/service/kinesisvideo"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lightsail"
 	"github.com/aws/aws-sdk-go/service/macie2"
 	"github.com/aws/aws-sdk-go/service/mediastore"
+	"github.com/aws/aws-sdk-go/service/mq"
 	"github.com/aws/aws-sdk-go/service/neptune"
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
 	"github.com/aws/aws-sdk-go/service/opensearchservice"
@@ -85,25 +100,32 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3control"
 	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/aws/aws-sdk-go/service/savingsplans"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/aws/aws-sdk-go/service/securityhub"
 	"github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
 	"github.com/aws/aws-sdk-go/service/servicequotas"
 	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/aws/aws-sdk-go/service/sesv2"
 	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/shield"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
 	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/support"
+	"github.com/aws/aws-sdk-go/service/synthetics"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/aws/aws-sdk-go/service/wafregional"
 	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/aws/aws-sdk-go/service/wellarchitected"
 	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/aws/aws-sdk-go/service/xray"
 
 	"github.com/turbot/go-kit/helpers"
 	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/context_key"
 )
 
 // AccessAnalyzerService returns the service connection for AWS IAM Access Analyzer service
@@ -128,6 +150,24 @@ func AccessAnalyzerService(ctx context.Context, d *plugin.QueryData) (*accessana
 	return svc, nil
 }
 
+// AccountService returns the service connection for AWS Account service
+func AccountService(ctx context.Context, d *plugin.QueryData) (*account.Account, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "Account"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*account.Account), nil
+	}
+	// so it was not in cache - create service
+	sess, err := getSession(ctx, d, GetDefaultAwsRegion(d))
+	if err != nil {
+		return nil, err
+	}
+	svc := account.New(sess)
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+
+	return svc, nil
+}
+
 // ACMService returns the service connection for AWS ACM service
 func ACMService(ctx context.Context, d *plugin.QueryData) (*acm.ACM, error) {
 	region := d.KeyColumnQualString(matrixKeyRegion)
@@ -279,6 +319,24 @@ func BackupService(ctx context.Context, d *plugin.QueryData) (*backup.Backup, er
 	return svc, nil
 }
 
+// BudgetsService returns the service connection for AWS Budgets service
+func BudgetsService(ctx context.Context, d *plugin.QueryData) (*budgets.Budgets, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "budgets"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*budgets.Budgets), nil
+	}
+	// so it was not in cache - create service
+	sess, err := getSession(ctx, d, GetDefaultAwsRegion(d))
+	if err != nil {
+		return nil, err
+	}
+	svc := budgets.New(sess)
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+
+	return svc, nil
+}
+
 // CloudControlService returns the service connection for AWS Cloud Control API service
 func CloudControlService(ctx context.Context, d *plugin.QueryData) (*cloudcontrolapi.CloudControlApi, error) {
 	region := d.KeyColumnQualString(matrixKeyRegion)
@@ -306,6 +364,27 @@ func CloudControlService(ctx context.Context, d *plugin.QueryData) (*cloudcontro
 	return svc, nil
 }
 
+// CodeArtifactService returns the service connection for AWS CodeArtifact service
+func CodeArtifactService(ctx context.Context, d *plugin.QueryData) (*codeartifact.CodeArtifact, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	if region == "" {
+		return nil, fmt.Errorf("region must be passed CodeArtifactService")
+	}
+	// have we already created and cached the service?
+	serviceCacheKey := fmt.Sprintf("codeartifact-%s", region)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*codeartifact.CodeArtifact), nil
+	}
+	// so it was not in cache - create service
+	sess, err := getSession(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+	svc := codeartifact.New(sess)
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
 // CodeBuildService returns the service connection for AWS CodeBuild service
 func CodeBuildService(ctx context.Context, d *plugin.QueryData) (*codebuild.CodeBuild, error) {
 	region := d.KeyColumnQualString(matrixKeyRegion)
@@ -348,6 +427,27 @@ func CodeCommitService(ctx context.Context, d *plugin.QueryData) (*codecommit.Co
 	return svc, nil
 }
 
+// CodeDeployService returns the service connection for AWS CodeDeploy service
+func CodeDeployService(ctx context.Context, d *plugin.QueryData) (*codedeploy.CodeDeploy, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	if region == "" {
+		return nil, fmt.Errorf("region must be passed CodeDeployService")
+	}
+	// have we already created and cached the service?
+	serviceCacheKey := fmt.Sprintf("codedeploy-%s", region)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*codedeploy.CodeDeploy), nil
+	}
+	// so it was not in cache - create service
+	sess, err := getSession(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+	svc := codedeploy.New(sess)
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
 // CodePipelineService returns the service connection for AWS Codepipeline service
 func CodePipelineService(ctx context.Context, d *plugin.QueryData) (*codepipeline.CodePipeline, error) {
 	region := d.KeyColumnQualString(matrixKeyRegion)
@@ -535,6 +635,27 @@ func DatabaseMigrationService(ctx context.Context, d *plugin.QueryData) (*databa
 	return svc, nil
 }
 
+// DirectConnectService returns the service connection for AWS Direct Connect service
+func DirectConnectService(ctx context.Context, d *plugin.QueryData, region string) (*directconnect.DirectConnect, error) {
+	if region == "" {
+		return nil, fmt.Errorf("region must be passed DirectConnectService")
+	}
+	// have we already created and cached the service?
+	serviceCacheKey := fmt.Sprintf("directconnect-%s", region)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*directconnect.DirectConnect), nil
+	}
+	// so it was not in cache - create service
+	sess, err := getSession(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+	svc := directconnect.New(sess)
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+
+	return svc, nil
+}
+
 // DirectoryService returns the service connection for AWS Directory service
 func DirectoryService(ctx context.Context, d *plugin.QueryData) (*directoryservice.DirectoryService, error) {
 	region := d.KeyColumnQualString(matrixKeyRegion)
@@ -579,6 +700,28 @@ func DLMService(ctx context.Context, d *plugin.QueryData) (*dlm.DLM, error) {
 	return svc, nil
 }
 
+// DocDBService returns the service connection for AWS DocumentDB service
+func DocDBService(ctx context.Context, d *plugin.QueryData) (*docdb.DocDB, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	if region == "" {
+		return nil, fmt.Errorf("region must be passed DocDBService")
+	}
+	// have we already created and cached the service?
+	serviceCacheKey := fmt.Sprintf("docdb-%s", region)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*docdb.DocDB), nil
+	}
+	// so it was not in cache - create service
+	sess, err := getSession(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+	svc := docdb.New(sess)
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+
+	return svc, nil
+}
+
 // DynamoDbService returns the service connection for AWS DynamoDb service
 func DynamoDbService(ctx context.Context, d *plugin.QueryData) (*dynamodb.DynamoDB, error) {
 	region := d.KeyColumnQualString(matrixKeyRegion)
@@ -941,6 +1084,28 @@ func FirehoseService(ctx context.Context, d *plugin.QueryData) (*firehose.Fireho
 	return svc, nil
 }
 
+// FMSService returns the service connection for AWS Firewall Manager service
+func FMSService(ctx context.Context, d *plugin.QueryData) (*fms.FMS, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	if region == "" {
+		return nil, fmt.Errorf("region must be passed FMSService")
+	}
+	// have we already created and cached the service?
+	serviceCacheKey := fmt.Sprintf("fms-%s", region)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*fms.FMS), nil
+	}
+	// so it was not in cache - create service
+	sess, err := getSession(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+	svc := fms.New(sess)
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+
+	return svc, nil
+}
+
 // GlacierService returns the service connection for AWS Glacier service
 func GlacierService(ctx context.Context, d *plugin.QueryData) (*glacier.Glacier, error) {
 	region := d.KeyColumnQualString(matrixKeyRegion)
@@ -965,6 +1130,26 @@ func GlacierService(ctx context.Context, d *plugin.QueryData) (*glacier.Glacier,
 	return svc, nil
 }
 
+// GlobalAcceleratorService returns the service connection for AWS Global Accelerator service
+func GlobalAcceleratorService(ctx context.Context, d *plugin.QueryData) (*globalaccelerator.GlobalAccelerator, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "globalaccelerator"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*globalaccelerator.GlobalAccelerator), nil
+	}
+
+	// Global Accelerator is a global service whose API is only available in
+	// us-west-2, regardless of the regions of the connection
+	sess, err := getSession(ctx, d, "us-west-2")
+	if err != nil {
+		return nil, err
+	}
+	svc := globalaccelerator.New(sess)
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+
+	return svc, nil
+}
+
 // GlueService returns the service connection for AWS Glue service
 func GlueService(ctx context.Context, d *plugin.QueryData) (*glue.Glue, error) {
 	region := d.KeyColumnQualString(matrixKeyRegion)
@@ -1076,6 +1261,28 @@ func InspectorService(ctx context.Context, d *plugin.QueryData) (*inspector.Insp
 	return svc, nil
 }
 
+// KafkaService returns the service connection for AWS Managed Streaming for Kafka service
+func KafkaService(ctx context.Context, d *plugin.QueryData) (*kafka.Kafka, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	if region == "" {
+		return nil, fmt.Errorf("region must be passed KafkaService")
+	}
+	// have we already created and cached the service?
+	serviceCacheKey := fmt.Sprintf("kafka-%s", region)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*kafka.Kafka), nil
+	}
+	// so it was not in cache - create service
+	sess, err := getSession(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+	svc := kafka.New(sess)
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+
+	return svc, nil
+}
+
 // KinesisService returns the service connection for AWS Kinesis service
 func KinesisService(ctx context.Context, d *plugin.QueryData) (*kinesis.Kinesis, error) {
 	region := d.KeyColumnQualString(matrixKeyRegion)
@@ -1186,6 +1393,28 @@ func LambdaService(ctx context.Context, d *plugin.QueryData) (*lambda.Lambda, er
 	return svc, nil
 }
 
+// LightsailService returns the service connection for AWS Lightsail service
+func LightsailService(ctx context.Context, d *plugin.QueryData) (*lightsail.Lightsail, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	if region == "" {
+		return nil, fmt.Errorf("region must be passed LightsailService")
+	}
+	// have we already created and cached the service?
+	serviceCacheKey := fmt.Sprintf("lightsail-%s", region)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*lightsail.Lightsail), nil
+	}
+	// so it was not in cache - create service
+	sess, err := getSession(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+	svc := lightsail.New(sess)
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+
+	return svc, nil
+}
+
 // Macie2Service returns the service connection for AWS Macie2 service
 func Macie2Service(ctx context.Context, d *plugin.QueryData) (*macie2.Macie2, error) {
 	region := d.KeyColumnQualString(matrixKeyRegion)
@@ -1230,6 +1459,28 @@ func MediaStoreService(ctx context.Context, d *plugin.QueryData) (*mediastore.Me
 	return svc, nil
 }
 
+// MQService returns the service connection for AWS MQ service
+func MQService(ctx context.Context, d *plugin.QueryData) (*mq.MQ, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	if region == "" {
+		return nil, fmt.Errorf("region must be passed MQService")
+	}
+	// have we already created and cached the service?
+	serviceCacheKey := fmt.Sprintf("mq-%s", region)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*mq.MQ), nil
+	}
+	// so it was not in cache - create service
+	sess, err := getSession(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+	svc := mq.New(sess)
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+
+	return svc, nil
+}
+
 // NeptuneService returns the service connection for AWS Neptune service
 func NeptuneService(ctx context.Context, d *plugin.QueryData) (*neptune.Neptune, error) {
 	region := d.KeyColumnQualString(matrixKeyRegion)
@@ -1430,10 +1681,8 @@ func RedshiftService(ctx context.Context, d *plugin.QueryData) (*redshift.Redshi
 
 // Route53DomainsService returns the service connection for AWS route53 domains service
 func Route53DomainsService(ctx context.Context, d *plugin.QueryData) (*route53domains.Route53Domains, error) {
+	// Route 53 Domains API is only available in us-east-1
 	region := "us-east-1"
-	if region == "" {
-		return nil, fmt.Errorf("region must be passed Route53Domains")
-	}
 	// have we already created and cached the service?
 	serviceCacheKey := fmt.Sprintf("route53domain-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
@@ -1595,6 +1844,24 @@ func SageMakerService(ctx context.Context, d *plugin.QueryData) (*sagemaker.Sage
 	return svc, nil
 }
 
+// SavingsPlansService returns the service connection for AWS Savings Plans service
+func SavingsPlansService(ctx context.Context, d *plugin.QueryData) (*savingsplans.SavingsPlans, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "savingsplans"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*savingsplans.SavingsPlans), nil
+	}
+	// so it was not in cache - create service
+	sess, err := getSession(ctx, d, GetDefaultAwsRegion(d))
+	if err != nil {
+		return nil, err
+	}
+	svc := savingsplans.New(sess)
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+
+	return svc, nil
+}
+
 // ServerlessApplicationRepositoryService returns the service connection for AWS Serverless Application Repository service
 func ServerlessApplicationRepositoryService(ctx context.Context, d *plugin.QueryData) (*serverlessapplicationrepository.ServerlessApplicationRepository, error) {
 	region := d.KeyColumnQualString(matrixKeyRegion)
@@ -1636,6 +1903,48 @@ func SESService(ctx context.Context, d *plugin.QueryData, region string) (*ses.S
 	return svc, nil
 }
 
+// SESV2Service returns the service connection for AWS SES v2 service
+func SESV2Service(ctx context.Context, d *plugin.QueryData) (*sesv2.SESV2, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	if region == "" {
+		return nil, fmt.Errorf("region must be passed SESV2Service")
+	}
+	// have we already created and cached the service?
+	serviceCacheKey := fmt.Sprintf("sesv2-%s", region)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*sesv2.SESV2), nil
+	}
+	// so it was not in cache - create service
+	sess, err := getSession(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+	svc := sesv2.New(sess)
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+
+	return svc, nil
+}
+
+// ShieldService returns the service connection for AWS Shield service
+func ShieldService(ctx context.Context, d *plugin.QueryData) (*shield.Shield, error) {
+	// Shield Advanced API is only available in us-east-1
+	region := "us-east-1"
+	// have we already created and cached the service?
+	serviceCacheKey := fmt.Sprintf("shield-%s", region)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*shield.Shield), nil
+	}
+	// so it was not in cache - create service
+	sess, err := getSession(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+	svc := shield.New(sess)
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+
+	return svc, nil
+}
+
 // SNSService returns the service connection for AWS SNS service
 func SNSService(ctx context.Context, d *plugin.QueryData) (*sns.SNS, error) {
 	region := d.KeyColumnQualString(matrixKeyRegion)
@@ -1805,6 +2114,56 @@ func StsService(ctx context.Context, d *plugin.QueryData) (*sts.STS, error) {
 	return svc, nil
 }
 
+// SupportService returns the service connection for AWS Support service
+func SupportService(ctx context.Context, d *plugin.QueryData) (*support.Support, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "support"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*support.Support), nil
+	}
+
+	// The Support API only has an endpoint in one region of each partition
+	region := "us-east-1"
+	defaultRegion := GetDefaultAwsRegion(d)
+	if strings.HasPrefix(defaultRegion, "us-gov") {
+		region = "us-gov-west-1"
+	} else if strings.HasPrefix(defaultRegion, "cn") {
+		region = "cn-north-1"
+	}
+
+	// so it was not in cache - create service
+	sess, err := getSession(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+	svc := support.New(sess)
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+
+	return svc, nil
+}
+
+// SyntheticsService returns the service connection for AWS CloudWatch Synthetics service
+func SyntheticsService(ctx context.Context, d *plugin.QueryData) (*synthetics.Synthetics, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	if region == "" {
+		return nil, fmt.Errorf("region must be passed SyntheticsService")
+	}
+	// have we already created and cached the service?
+	serviceCacheKey := fmt.Sprintf("synthetics-%s", region)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*synthetics.Synthetics), nil
+	}
+	// so it was not in cache - create service
+	sess, err := getSession(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+	svc := synthetics.New(sess)
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+
+	return svc, nil
+}
+
 // TaggignResourceService returns the service connection for AWS ResourceTaggingAPI service
 func TaggignResourceService(ctx context.Context, d *plugin.QueryData) (*resourcegroupstaggingapi.ResourceGroupsTaggingAPI, error) {
 	region := d.KeyColumnQualString(matrixKeyRegion)
@@ -1937,6 +2296,28 @@ func WorkspacesService(ctx context.Context, d *plugin.QueryData) (*workspaces.Wo
 	return svc, nil
 }
 
+// XRayService returns the service connection for AWS X-Ray service
+func XRayService(ctx context.Context, d *plugin.QueryData) (*xray.XRay, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	if region == "" {
+		return nil, fmt.Errorf("region must be passed XRayService")
+	}
+	// have we already created and cached the service?
+	serviceCacheKey := fmt.Sprintf("xray-%s", region)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*xray.XRay), nil
+	}
+	// so it was not in cache - create service
+	sess, err := getSession(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+	svc := xray.New(sess)
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+
+	return svc, nil
+}
+
 func getSession(ctx context.Context, d *plugin.QueryData, region string) (*session.Session, error) {
 	awsConfig := GetConfig(d.Connection)
 
@@ -1994,16 +2375,40 @@ func getSessionWithMaxRetries(ctx context.Context, d *plugin.QueryData, region s
 	}
 
 	// handle custom endpoint URL, if any
-	var awsEndpointUrl string
+	if awsEndpointUrl := getEndpointUrl(awsConfig); awsEndpointUrl != "" {
+		sessionOptions.Config.Endpoint = aws.String(awsEndpointUrl)
+	}
+
+	// S3 compatible services often only support path style addressing
+	if awsConfig.S3ForcePathStyle != nil {
+		sessionOptions.Config.S3ForcePathStyle = awsConfig.S3ForcePathStyle
+	}
 
-	awsEndpointUrl = os.Getenv("AWS_ENDPOINT_URL")
+	var transport http.RoundTripper
 
-	if awsConfig.EndpointUrl != nil {
-		awsEndpointUrl = *awsConfig.EndpointUrl
+	// Allow self-signed certificates, e.g. when testing against a local endpoint
+	if awsConfig.InsecureSkipVerify != nil && *awsConfig.InsecureSkipVerify {
+		insecureTransport := http.DefaultTransport.(*http.Transport).Clone()
+		insecureTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} // #nosec G402
+		transport = insecureTransport
 	}
 
-	if awsEndpointUrl != "" {
-		sessionOptions.Config.Endpoint = aws.String(awsEndpointUrl)
+	// Bound the number of API calls in flight across all regions of the connection
+	if awsConfig.MaxConcurrency != nil {
+		if *awsConfig.MaxConcurrency < 1 {
+			panic("\nconnection config has invalid value for \"max_concurrency\", it must be greater than or equal to 1. Edit your connection configuration file and then restart Steampipe.")
+		}
+		if transport == nil {
+			transport = http.DefaultTransport
+		}
+		transport = &concurrencyLimitedTransport{
+			transport: transport,
+			sem:       getConnectionSemaphore(d.Connection.Name, *awsConfig.MaxConcurrency),
+		}
+	}
+
+	if transport != nil {
+		sessionOptions.Config.HTTPClient = &http.Client{Transport: transport}
 	}
 
 	if awsConfig.Profile != nil {
@@ -2026,18 +2431,103 @@ func getSessionWithMaxRetries(ctx context.Context, d *plugin.QueryData, region s
 		}
 	}
 
+	if awsConfig.RoleArn == nil && (awsConfig.ExternalId != nil || awsConfig.SessionName != nil || awsConfig.Duration != nil) {
+		return nil, fmt.Errorf("Partial assume role configuration found in connection config, missing: role_arn")
+	}
+
 	sess, err := session.NewSessionWithOptions(sessionOptions)
 	if err != nil {
 		plugin.Logger(ctx).Error("getSessionWithMaxRetries", "new_session_with_options", err)
 		return nil, err
 	}
 
+	// Use the base credentials to assume the configured role, if any
+	if awsConfig.RoleArn != nil {
+		sess = sess.Copy(&aws.Config{
+			Credentials: getAssumeRoleCredentials(d, sess, awsConfig),
+		})
+	}
+
 	// save session in cache
 	d.ConnectionManager.Cache.Set(sessionCacheKey, sess)
 
 	return sess, nil
 }
 
+// connectionSemaphores holds the semaphore bounding the API calls of each
+// connection, keyed by connection name and max concurrency
+var connectionSemaphores sync.Map
+
+func getConnectionSemaphore(connectionName string, maxConcurrency int) chan struct{} {
+	key := fmt.Sprintf("%s-%d", connectionName, maxConcurrency)
+	sem, _ := connectionSemaphores.LoadOrStore(key, make(chan struct{}, maxConcurrency))
+	return sem.(chan struct{})
+}
+
+// concurrencyLimitedTransport waits for a free slot in the semaphore before
+// sending each request, so at most cap(sem) requests are in flight. The slot
+// is released once the response headers arrive, before the body is read.
+type concurrencyLimitedTransport struct {
+	transport http.RoundTripper
+	sem       chan struct{}
+}
+
+func (t *concurrencyLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	select {
+	case t.sem <- struct{}{}:
+	default:
+		// Requests made without a query context have no logger
+		if ctx.Value(context_key.Logger) != nil {
+			plugin.Logger(ctx).Debug("concurrencyLimitedTransport.RoundTrip", "waiting for a free slot", req.URL.Host, "max_concurrency", cap(t.sem))
+		}
+		select {
+		case t.sem <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	defer func() { <-t.sem }()
+
+	return t.transport.RoundTrip(req)
+}
+
+// getEndpointUrl returns the custom endpoint URL set in the connection config
+// or the AWS_ENDPOINT_URL environment variable, the config taking precedence
+func getEndpointUrl(awsConfig awsConfig) string {
+	if awsConfig.EndpointUrl != nil {
+		return *awsConfig.EndpointUrl
+	}
+	return os.Getenv("AWS_ENDPOINT_URL")
+}
+
+// getAssumeRoleCredentials returns the credentials for the role configured in
+// the connection. The credentials are shared by the sessions of all regions and
+// are refreshed shortly before they expire, so long running queries keep working.
+func getAssumeRoleCredentials(d *plugin.QueryData, sess *session.Session, awsConfig awsConfig) *credentials.Credentials {
+	cacheKey := "assume-role-credentials"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(*credentials.Credentials)
+	}
+
+	creds := stscreds.NewCredentials(sess, *awsConfig.RoleArn, func(p *stscreds.AssumeRoleProvider) {
+		if awsConfig.ExternalId != nil {
+			p.ExternalID = awsConfig.ExternalId
+		}
+		if awsConfig.SessionName != nil {
+			p.RoleSessionName = *awsConfig.SessionName
+		}
+		if awsConfig.Duration != nil {
+			p.Duration = time.Duration(*awsConfig.Duration) * time.Second
+		}
+		p.ExpiryWindow = time.Minute
+	})
+	d.ConnectionManager.Cache.Set(cacheKey, creds)
+
+	return creds
+}
+
 // GetDefaultAwsRegion returns the default region for AWS partiton
 // if not set by Env variable or in aws profile
 func GetDefaultAwsRegion(d *plugin.QueryData) string {
@@ -2088,7 +2578,8 @@ func GetDefaultAwsRegion(d *plugin.QueryData) string {
 		}
 	}
 
-	if len(invalidPatterns) > 0 {
+	// Custom endpoints may use region names unknown to AWS
+	if len(invalidPatterns) > 0 && getEndpointUrl(awsConfig) == "" {
 		panic("\nconnection config has invalid \"regions\": " + strings.Join(invalidPatterns, ", ") + ". Edit your connection configuration file and then restart Steampipe.")
 	}
 
@@ -0,0 +1,302 @@
+package aws
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+type accessAnalyzerFindingInfo struct {
+	Finding     *accessanalyzer.FindingSummary
+	AnalyzerArn *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsAccessAnalyzerFinding(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_accessanalyzer_finding",
+		Description: "AWS Access Analyzer Finding",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"analyzer_arn", "id"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFoundException", "ValidationException", "InvalidParameter"}),
+			},
+			Hydrate: getAccessAnalyzerFinding,
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listAccessAnalyzers,
+			Hydrate:       listAccessAnalyzerFindingRows,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "analyzer_arn", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+				{Name: "resource_type", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+				{Name: "is_public", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+			},
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the finding.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Finding.Id"),
+			},
+			{
+				Name:        "analyzer_arn",
+				Description: "The ARN of the analyzer that generated the finding.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource",
+				Description: "The resource that the external principal has access to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Finding.Resource"),
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the resource that the external principal has access to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Finding.ResourceType"),
+			},
+			{
+				Name:        "resource_owner_account",
+				Description: "The Amazon Web Services account ID that owns the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Finding.ResourceOwnerAccount"),
+			},
+			{
+				Name:        "is_public",
+				Description: "Indicates whether the finding reports a resource that has a policy that allows public access.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Finding.IsPublic"),
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the finding, e.g. ACTIVE, ARCHIVED or RESOLVED.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Finding.Status"),
+			},
+			{
+				Name:        "error",
+				Description: "The error that resulted in an Error finding.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Finding.Error"),
+			},
+			{
+				Name:        "created_at",
+				Description: "The time at which the finding was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Finding.CreatedAt"),
+			},
+			{
+				Name:        "analyzed_at",
+				Description: "The time at which the resource-based policy that generated the finding was analyzed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Finding.AnalyzedAt"),
+			},
+			{
+				Name:        "updated_at",
+				Description: "The time at which the finding was most recently updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Finding.UpdatedAt"),
+			},
+			{
+				Name:        "action",
+				Description: "The action in the analyzed policy statement that an external principal has permission to use.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Finding.Action"),
+			},
+			{
+				Name:        "condition",
+				Description: "The condition in the analyzed policy statement that resulted in a finding.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Finding.Condition"),
+			},
+			{
+				Name:        "principal",
+				Description: "The external principal that has access to a resource within the zone of trust.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Finding.Principal"),
+			},
+			{
+				Name:        "sources",
+				Description: "The sources of the finding, which indicate how the access that generated the finding is granted.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Finding.Sources"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Finding.Id"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAccessAnalyzerFindingRows(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	analyzer := h.Item.(*accessanalyzer.AnalyzerSummary)
+
+	// Minimize the API call with the given analyzer arn
+	if d.KeyColumnQuals["analyzer_arn"] != nil && d.KeyColumnQuals["analyzer_arn"].GetStringValue() != *analyzer.Arn {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := AccessAnalyzerService(ctx, d)
+	if err != nil {
+		logger.Trace("listAccessAnalyzerFindingRows", "connection error", err)
+		return nil, err
+	}
+
+	// The maximum number for MaxResults parameter is not defined by the API
+	// We have set the MaxResults to 1000 based on our test
+	input := &accessanalyzer.ListFindingsInput{
+		AnalyzerArn: analyzer.Arn,
+		MaxResults:  aws.Int64(1000),
+	}
+
+	filter := buildAccessAnalyzerFindingFilter(d.Quals)
+	if len(filter) > 0 {
+		input.Filter = filter
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxResults {
+			if *limit < 1 {
+				input.MaxResults = aws.Int64(1)
+			} else {
+				input.MaxResults = limit
+			}
+		}
+	}
+
+	// List call
+	err = svc.ListFindingsPages(
+		input,
+		func(page *accessanalyzer.ListFindingsOutput, isLast bool) bool {
+			for _, finding := range page.Findings {
+				d.StreamListItem(ctx, &accessAnalyzerFindingInfo{finding, analyzer.Arn})
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAccessAnalyzerFinding(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getAccessAnalyzerFinding")
+
+	analyzerArn := d.KeyColumnQuals["analyzer_arn"].GetStringValue()
+	id := d.KeyColumnQuals["id"].GetStringValue()
+
+	// Create Session
+	svc, err := AccessAnalyzerService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the params
+	params := &accessanalyzer.GetFindingInput{
+		AnalyzerArn: aws.String(analyzerArn),
+		Id:          aws.String(id),
+	}
+
+	// Get call
+	data, err := svc.GetFinding(params)
+	if err != nil {
+		plugin.Logger(ctx).Debug("getAccessAnalyzerFinding", "ERROR", err)
+		return nil, err
+	}
+
+	finding := data.Finding
+	return &accessAnalyzerFindingInfo{
+		Finding: &accessanalyzer.FindingSummary{
+			Action:               finding.Action,
+			AnalyzedAt:           finding.AnalyzedAt,
+			Condition:            finding.Condition,
+			CreatedAt:            finding.CreatedAt,
+			Error:                finding.Error,
+			Id:                   finding.Id,
+			IsPublic:             finding.IsPublic,
+			Principal:            finding.Principal,
+			Resource:             finding.Resource,
+			ResourceOwnerAccount: finding.ResourceOwnerAccount,
+			ResourceType:         finding.ResourceType,
+			Sources:              finding.Sources,
+			Status:               finding.Status,
+			UpdatedAt:            finding.UpdatedAt,
+		},
+		AnalyzerArn: aws.String(analyzerArn),
+	}, nil
+}
+
+//// UTILITY FUNCTION
+
+// Build access analyzer finding filter param
+func buildAccessAnalyzerFindingFilter(quals plugin.KeyColumnQualMap) map[string]*accessanalyzer.Criterion {
+	filter := make(map[string]*accessanalyzer.Criterion)
+
+	filterQuals := map[string]string{
+		"status":        "status",
+		"resource_type": "resourceType",
+		"is_public":     "isPublic",
+	}
+
+	for columnName, filterName := range filterQuals {
+		if quals[columnName] == nil {
+			continue
+		}
+		for _, q := range quals[columnName].Quals {
+			var values []*string
+			if columnName == "is_public" {
+				values = []*string{aws.String(strconv.FormatBool(q.Value.GetBoolValue()))}
+			} else if q.Value.GetStringValue() != "" {
+				values = []*string{aws.String(q.Value.GetStringValue())}
+			} else {
+				values = getListValues(q.Value.GetListValue())
+			}
+			if len(values) == 0 {
+				continue
+			}
+
+			criterion, ok := filter[filterName]
+			if !ok {
+				criterion = &accessanalyzer.Criterion{}
+				filter[filterName] = criterion
+			}
+			switch q.Operator {
+			case "=":
+				criterion.Eq = append(criterion.Eq, values...)
+			case "<>":
+				criterion.Neq = append(criterion.Neq, values...)
+			}
+		}
+	}
+
+	return filter
+}
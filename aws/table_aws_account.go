@@ -78,7 +78,7 @@ func tableAwsAccount(ctx context.Context) *plugin.Table {
 			},
 			{
 				Name:        "organization_available_policy_types",
-				Description: "The Region opt-in status. The possible values are opt-in-not-required, opted-in, and not-opted-in",
+				Description: "A list of policy types that are enabled for the organization.",
 				Type:        proto.ColumnType_JSON,
 				Hydrate:     getOrganizationDetails,
 				Transform:   transform.FromField("Organization.AvailablePolicyTypes"),
@@ -0,0 +1,107 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/account"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsAccountAlternateContact(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_account_alternate_contact",
+		Description: "AWS Account Alternate Contact",
+		List: &plugin.ListConfig{
+			Hydrate: listAwsAccountAlternateContacts,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "contact_type", Require: plugin.Optional},
+			},
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name associated with this alternate contact.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "contact_type",
+				Description: "The type of alternate contact, either BILLING, OPERATIONS or SECURITY.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlternateContactType"),
+			},
+			{
+				Name:        "contact_title",
+				Description: "The title associated with this alternate contact.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Title"),
+			},
+			{
+				Name:        "email_address",
+				Description: "The email address associated with this alternate contact.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "phone_number",
+				Description: "The phone number associated with this alternate contact.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsAccountAlternateContacts(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listAwsAccountAlternateContacts")
+
+	// Create Session
+	svc, err := AccountService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	contactTypes := account.AlternateContactType_Values()
+	if d.KeyColumnQuals["contact_type"] != nil {
+		contactTypes = []string{d.KeyColumnQuals["contact_type"].GetStringValue()}
+	}
+
+	for _, contactType := range contactTypes {
+		op, err := svc.GetAlternateContact(&account.GetAlternateContactInput{
+			AlternateContactType: aws.String(contactType),
+		})
+		if err != nil {
+			// No contact of this type has been set for the account
+			if a, ok := err.(awserr.Error); ok {
+				if a.Code() == "ResourceNotFoundException" {
+					continue
+				}
+			}
+			plugin.Logger(ctx).Error("listAwsAccountAlternateContacts", "GetAlternateContact_error", err)
+			return nil, err
+		}
+
+		d.StreamListItem(ctx, op.AlternateContact)
+
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
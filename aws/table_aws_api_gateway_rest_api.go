@@ -103,6 +103,12 @@ func tableAwsAPIGatewayRestAPI(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("EndpointConfiguration.VpcEndpointIds"),
 			},
+			{
+				Name:        "disable_execute_api_endpoint",
+				Description: "Specifies whether clients can invoke the API by using the default execute-api endpoint.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("DisableExecuteApiEndpoint"),
+			},
 			{
 				Name:        "warnings",
 				Description: "The warning messages reported when failonwarnings is turned on during API import",
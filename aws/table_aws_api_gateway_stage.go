@@ -128,9 +128,9 @@ func tableAwsAPIGatewayStage(_ context.Context) *plugin.Table {
 			},
 			{
 				Name:        "variables",
-				Description: "A map that defines the stage variables for a Stage resource.",
+				Description: "A map that defines the stage variables for a Stage resource. Variable values are masked since they commonly hold credentials.",
 				Type:        proto.ColumnType_JSON,
-				Transform:   transform.FromField("Stage.Variables"),
+				Transform:   transform.FromField("Stage.Variables").Transform(maskAPIGatewayStageVariables),
 			},
 			{
 				Name:        "web_acl_arn",
@@ -244,3 +244,19 @@ func getAPIGatewayStageARN(ctx context.Context, d *plugin.QueryData, h *plugin.H
 	arn := "arn:" + commonColumnData.Partition + ":apigateway:" + region + "::/restapis/" + *apiStage.RestAPIId + "/stages/" + *apiStage.Stage.StageName
 	return arn, nil
 }
+
+//// TRANSFORM FUNCTIONS
+
+func maskAPIGatewayStageVariables(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	variables, ok := d.Value.(map[string]*string)
+	if !ok || len(variables) == 0 {
+		return nil, nil
+	}
+
+	masked := map[string]string{}
+	for k := range variables {
+		masked[k] = "********"
+	}
+
+	return masked, nil
+}
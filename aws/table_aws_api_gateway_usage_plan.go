@@ -64,6 +64,13 @@ func tableAwsAPIGatewayUsagePlan(_ context.Context) *plugin.Table {
 				Description: "The associated API stages of a usage plan",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "keys",
+				Description: "The API keys associated with the usage plan. Key values are not included.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getUsagePlanKeys,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "tags",
 				Description: resourceInterfaceDescription("tags"),
@@ -157,6 +164,44 @@ func getUsagePlan(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDat
 	return op, nil
 }
 
+func getUsagePlanKeys(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getUsagePlanKeys")
+	usagePlan := h.Item.(*apigateway.UsagePlan)
+
+	// Create session
+	svc, err := APIGatewayService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &apigateway.GetUsagePlanKeysInput{
+		UsagePlanId: usagePlan.Id,
+		Limit:       aws.Int64(500),
+	}
+
+	var keys []*apigateway.UsagePlanKey
+	err = svc.GetUsagePlanKeysPages(
+		params,
+		func(page *apigateway.GetUsagePlanKeysOutput, lastPage bool) bool {
+			for _, key := range page.Items {
+				// Drop the API key value, only the identity of the key is exposed
+				keys = append(keys, &apigateway.UsagePlanKey{
+					Id:   key.Id,
+					Name: key.Name,
+					Type: key.Type,
+				})
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("getUsagePlanKeys", "GetUsagePlanKeysPages_error", err)
+		return nil, err
+	}
+
+	return keys, nil
+}
+
 func getUsagePlanAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	plugin.Logger(ctx).Trace("getUsagePlanAkas")
 	region := d.KeyColumnQualString(matrixKeyRegion)
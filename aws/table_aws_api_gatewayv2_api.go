@@ -63,6 +63,16 @@ func tableAwsAPIGatewayV2Api(_ context.Context) *plugin.Table {
 				Description: "The timestamp when the API was created",
 				Type:        proto.ColumnType_TIMESTAMP,
 			},
+			{
+				Name:        "disable_execute_api_endpoint",
+				Description: "Specifies whether clients can invoke your API by using the default execute-api endpoint",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "cors_configuration",
+				Description: "A CORS configuration. Supported only for HTTP APIs",
+				Type:        proto.ColumnType_JSON,
+			},
 			{
 				Name:        "tags",
 				Description: resourceInterfaceDescription("tags"),
@@ -157,6 +167,8 @@ func getAPIGatewayV2API(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 			ApiKeySelectionExpression: apiData.ApiKeySelectionExpression,
 			RouteSelectionExpression:  apiData.RouteSelectionExpression,
 			CreatedDate:               apiData.CreatedDate,
+			DisableExecuteApiEndpoint: apiData.DisableExecuteApiEndpoint,
+			CorsConfiguration:         apiData.CorsConfiguration,
 			Tags:                      apiData.Tags,
 		}
 		return api, nil
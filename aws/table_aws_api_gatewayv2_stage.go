@@ -119,6 +119,24 @@ func tableAwsAPIGatewayV2Stage(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Stage.Description"),
 			},
+			{
+				Name:        "access_log_settings",
+				Description: "Settings for logging access in this stage",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Stage.AccessLogSettings"),
+			},
+			{
+				Name:        "default_route_settings",
+				Description: "Default route settings for the stage, including throttling limits and whether detailed metrics are enabled",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Stage.DefaultRouteSettings"),
+			},
+			{
+				Name:        "route_settings",
+				Description: "Route settings for the stage, by route key",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Stage.RouteSettings"),
+			},
 			{
 				Name:        "stage_variables",
 				Description: "A map that defines the stage variables for a stage resource",
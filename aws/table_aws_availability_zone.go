@@ -56,6 +56,11 @@ func tableAwsAvailabilityZone(_ context.Context) *plugin.Table {
 				Description: "The type of zone. The valid values are availability-zone, local-zone, and wavelength-zone.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "state",
+				Description: "The state of the Availability Zone, Local Zone, or Wavelength Zone. This value is always available.",
+				Type:        proto.ColumnType_STRING,
+			},
 			{
 				Name:        "opt_in_status",
 				Description: "For Availability Zones, this parameter always has the value of opt-in-not-required. For Local Zones and Wavelength Zones, this parameter is the opt-in status. The possible values are opted-in, and not-opted-in.",
@@ -66,6 +71,11 @@ func tableAwsAvailabilityZone(_ context.Context) *plugin.Table {
 				Description: "For Availability Zones, this parameter has the same value as the Region name. For Local Zones, the name of the associated group, for example us-west-2-lax-1. For Wavelength Zones, the name of the associated group, for example us-east-1-wl1-bos-wlz-1.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "network_border_group",
+				Description: "The name of the network border group, a unique set of Availability Zones or Local Zones from where AWS advertises IP addresses.",
+				Type:        proto.ColumnType_STRING,
+			},
 			{
 				Name:        "region_name",
 				Description: "The name of the Region.",
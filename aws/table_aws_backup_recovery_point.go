@@ -30,17 +30,22 @@ func tableAwsBackupRecoveryPoint(_ context.Context) *plugin.Table {
 			Hydrate:       listAwsBackupRecoveryPoints,
 			KeyColumns: []*plugin.KeyColumn{
 				{
-					Name:    "recovery_point_arn",
+					Name:    "backup_vault_name",
 					Require: plugin.Optional,
 				},
 				{
-					Name:    "resource_type",
+					Name:    "resource_arn",
 					Require: plugin.Optional,
 				},
 				{
-					Name:    "completion_date",
+					Name:    "resource_type",
 					Require: plugin.Optional,
 				},
+				{
+					Name:      "creation_date",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "<", "<="},
+				},
 			},
 		},
 		GetMatrixItem: BuildRegionList,
@@ -159,6 +164,11 @@ func listAwsBackupRecoveryPoints(ctx context.Context, d *plugin.QueryData, h *pl
 	plugin.Logger(ctx).Trace("listAwsBackupRecoveryPoints")
 	vault := h.Item.(*backup.VaultListMember)
 
+	// Minimize the API call with the given vault name
+	if d.KeyColumnQuals["backup_vault_name"] != nil && d.KeyColumnQuals["backup_vault_name"].GetStringValue() != *vault.BackupVaultName {
+		return nil, nil
+	}
+
 	// Create session
 	svc, err := BackupService(ctx, d)
 	if err != nil {
@@ -172,14 +182,22 @@ func listAwsBackupRecoveryPoints(ctx context.Context, d *plugin.QueryData, h *pl
 
 	// Additonal Filter
 	equalQuals := d.KeyColumnQuals
-	if equalQuals["recovery_point_arn"] != nil {
-		input.ByResourceArn = types.String(equalQuals["recovery_point_arn"].GetStringValue())
+	if equalQuals["resource_arn"] != nil {
+		input.ByResourceArn = types.String(equalQuals["resource_arn"].GetStringValue())
 	}
 	if equalQuals["resource_type"] != nil {
 		input.ByResourceType = types.String(equalQuals["resource_type"].GetStringValue())
 	}
-	if equalQuals["completion_date"] != nil {
-		input.ByCreatedAfter = types.Time(equalQuals["completion_date"].GetTimestampValue().AsTime())
+	if d.Quals["creation_date"] != nil {
+		for _, q := range d.Quals["creation_date"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case ">", ">=":
+				input.ByCreatedAfter = types.Time(timestamp)
+			case "<", "<=":
+				input.ByCreatedBefore = types.Time(timestamp)
+			}
+		}
 	}
 
 	// Limiting the results
@@ -63,6 +63,26 @@ func tableAwsBackupVault(_ context.Context) *plugin.Table {
 				Description: "The number of recovery points that are stored in a backup vault.",
 				Type:        proto.ColumnType_DOUBLE,
 			},
+			{
+				Name:        "locked",
+				Description: "Indicates whether Backup Vault Lock is currently protecting the backup vault.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "lock_date",
+				Description: "The date and time when Backup Vault Lock configuration becomes immutable, meaning it cannot be changed or deleted.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "min_retention_days",
+				Description: "The Backup Vault Lock setting that specifies the minimum retention period that the vault retains its recovery points.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "max_retention_days",
+				Description: "The Backup Vault Lock setting that specifies the maximum retention period that the vault retains its recovery points.",
+				Type:        proto.ColumnType_INT,
+			},
 			{
 				Name:        "sns_topic_arn",
 				Description: "An ARN that uniquely identifies an Amazon Simple Notification Service.",
@@ -196,8 +216,8 @@ func getAwsBackupVaultNotification(ctx context.Context, d *plugin.QueryData, h *
 			if a.Code() == "ResourceNotFoundException" || a.Code() == "InvalidParameter" {
 				return backup.GetBackupVaultNotificationsOutput{}, nil
 			}
-			return nil, err
 		}
+		return nil, err
 	}
 	return op, nil
 }
@@ -219,8 +239,8 @@ func getAwsBackupVaultAccessPolicy(ctx context.Context, d *plugin.QueryData, h *
 			if a.Code() == "ResourceNotFoundException" || a.Code() == "InvalidParameter" {
 				return backup.GetBackupVaultAccessPolicyOutput{}, nil
 			}
-			return nil, err
 		}
+		return nil, err
 	}
 	return op, nil
 }
@@ -0,0 +1,288 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/budgets"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsBudgetsBudget(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_budgets_budget",
+		Description: "AWS Budgets Budget",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"NotFoundException"}),
+			},
+			Hydrate: getBudgetsBudget,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listBudgetsBudgets,
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the budget.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BudgetName"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the budget.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getBudgetsBudgetArn,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "budget_type",
+				Description: "Whether the budget tracks cost, usage, RI utilization, RI coverage, Savings Plans utilization or Savings Plans coverage.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_unit",
+				Description: "The length of time until the budget resets the actual and forecasted spend, e.g. DAILY, MONTHLY, QUARTERLY or ANNUALLY.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_updated_time",
+				Description: "The last time that the budget was updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "budget_limit",
+				Description: "The total amount of cost, usage, RI utilization, RI coverage, Savings Plans utilization or Savings Plans coverage that the budget tracks.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "planned_budget_limits",
+				Description: "A map containing multiple budget limits, keyed by the start time of each budget period.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "calculated_spend",
+				Description: "The actual and forecasted cost or usage that the budget tracks.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "cost_filters",
+				Description: "The cost filters, such as service or tag, that are applied to the budget.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "cost_types",
+				Description: "The types of costs, such as refunds, credits or taxes, that are included in the budget.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "time_period",
+				Description: "The period of time that is covered by the budget.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "notifications",
+				Description: "The notifications of the budget, each with the subscribers that are notified.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBudgetsBudgetNotifications,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BudgetName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBudgetsBudgetArn,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+type budgetNotification struct {
+	*budgets.Notification
+	Subscribers []*budgets.Subscriber
+}
+
+//// LIST FUNCTION
+
+func listBudgetsBudgets(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listBudgetsBudgets")
+
+	// Create Session
+	svc, err := BudgetsService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	input := &budgets.DescribeBudgetsInput{
+		AccountId:  aws.String(commonColumnData.AccountId),
+		MaxResults: aws.Int64(100),
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxResults {
+			if *limit < 1 {
+				input.MaxResults = aws.Int64(1)
+			} else {
+				input.MaxResults = limit
+			}
+		}
+	}
+
+	// List call
+	err = svc.DescribeBudgetsPages(
+		input,
+		func(page *budgets.DescribeBudgetsOutput, isLast bool) bool {
+			for _, budget := range page.Budgets {
+				d.StreamListItem(ctx, budget)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("listBudgetsBudgets", "DescribeBudgetsPages_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getBudgetsBudget(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getBudgetsBudget")
+
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := BudgetsService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	params := &budgets.DescribeBudgetInput{
+		AccountId:  aws.String(commonColumnData.AccountId),
+		BudgetName: aws.String(name),
+	}
+
+	op, err := svc.DescribeBudget(params)
+	if err != nil {
+		plugin.Logger(ctx).Debug("getBudgetsBudget", "ERROR", err)
+		return nil, err
+	}
+
+	return op.Budget, nil
+}
+
+func getBudgetsBudgetNotifications(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getBudgetsBudgetNotifications")
+	budgetName := h.Item.(*budgets.Budget).BudgetName
+
+	// Create Session
+	svc, err := BudgetsService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	accountId := aws.String(commonData.(*awsCommonColumnData).AccountId)
+
+	var notifications []*budgets.Notification
+	err = svc.DescribeNotificationsForBudgetPages(
+		&budgets.DescribeNotificationsForBudgetInput{
+			AccountId:  accountId,
+			BudgetName: budgetName,
+		},
+		func(page *budgets.DescribeNotificationsForBudgetOutput, isLast bool) bool {
+			notifications = append(notifications, page.Notifications...)
+			return !isLast
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("getBudgetsBudgetNotifications", "DescribeNotificationsForBudgetPages_error", err)
+		return nil, err
+	}
+
+	var result []budgetNotification
+	for _, notification := range notifications {
+		var subscribers []*budgets.Subscriber
+		err = svc.DescribeSubscribersForNotificationPages(
+			&budgets.DescribeSubscribersForNotificationInput{
+				AccountId:    accountId,
+				BudgetName:   budgetName,
+				Notification: notification,
+			},
+			func(page *budgets.DescribeSubscribersForNotificationOutput, isLast bool) bool {
+				subscribers = append(subscribers, page.Subscribers...)
+				return !isLast
+			},
+		)
+		if err != nil {
+			plugin.Logger(ctx).Error("getBudgetsBudgetNotifications", "DescribeSubscribersForNotificationPages_error", err)
+			return nil, err
+		}
+		result = append(result, budgetNotification{notification, subscribers})
+	}
+
+	return result, nil
+}
+
+func getBudgetsBudgetArn(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getBudgetsBudgetArn")
+	budgetName := h.Item.(*budgets.Budget).BudgetName
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	arn := "arn:" + commonColumnData.Partition + ":budgets::" + commonColumnData.AccountId + ":budget/" + *budgetName
+
+	return arn, nil
+}
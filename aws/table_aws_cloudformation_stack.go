@@ -55,6 +55,12 @@ func tableAwsCloudFormationStack(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("StackStatus"),
 			},
+			{
+				Name:        "status_reason",
+				Description: "Success/failure message associated with the stack status.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StackStatusReason"),
+			},
 			{
 				Name:        "creation_time",
 				Description: "The time at which the stack was created.",
@@ -123,6 +129,12 @@ func tableAwsCloudFormationStack(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("DriftInformation.StackDriftStatus"),
 			},
+			{
+				Name:        "stack_drift_last_check_timestamp",
+				Description: "Most recent time when a drift detection operation was initiated on the stack, or any of its individual resources that support drift detection.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("DriftInformation.LastCheckTimestamp"),
+			},
 			{
 				Name:        "parameters",
 				Description: "A list of Parameter structures.",
@@ -201,6 +213,11 @@ func listCloudFormationStacks(ctx context.Context, d *plugin.QueryData, _ *plugi
 		input,
 		func(page *cloudformation.DescribeStacksOutput, lastPage bool) bool {
 			for _, stack := range page.Stacks {
+				// DescribeStacks also returns deleted stacks when queried by stack ID,
+				// which would otherwise show up next to the live stack of the same name
+				if aws.StringValue(stack.StackStatus) == cloudformation.StackStatusDeleteComplete {
+					continue
+				}
 				d.StreamListItem(ctx, stack)
 
 				// Context can be cancelled due to manual cancellation or the limit has been hit
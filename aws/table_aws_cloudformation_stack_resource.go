@@ -0,0 +1,132 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCloudFormationStackResource(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudformation_stack_resource",
+		Description: "AWS CloudFormation Stack Resource",
+		List: &plugin.ListConfig{
+			Hydrate: listCloudFormationStackResources,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "stack_name", Require: plugin.Required},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ValidationError"}),
+			},
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "stack_name",
+				Description: "The name or unique stack ID of the stack the resource belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("stack_name"),
+			},
+			{
+				Name:        "logical_resource_id",
+				Description: "The logical name of the resource specified in the template.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "physical_resource_id",
+				Description: "The name or unique identifier that corresponds to a physical instance ID of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_type",
+				Description: "Type of resource, e.g. AWS::EC2::Instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_status",
+				Description: "Current status of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_status_reason",
+				Description: "Success/failure message associated with the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_updated_timestamp",
+				Description: "Time the status was updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "drift_status",
+				Description: "Status of the resource's actual configuration compared to its expected configuration, e.g. IN_SYNC, MODIFIED, DELETED or NOT_CHECKED.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DriftInformation.StackResourceDriftStatus"),
+			},
+			{
+				Name:        "drift_last_check_timestamp",
+				Description: "When CloudFormation last checked if the resource had drifted from its expected configuration.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("DriftInformation.LastCheckTimestamp"),
+			},
+			{
+				Name:        "module_info",
+				Description: "Contains information about the module from which the resource was created, if the resource was created from a module included in the stack template.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LogicalResourceId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudFormationStackResources(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	stackName := d.KeyColumnQuals["stack_name"].GetStringValue()
+
+	// Empty check
+	if stackName == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := CloudFormationService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// We can not pass the MaxResult value in param so we can't limit the result per page
+	input := &cloudformation.ListStackResourcesInput{
+		StackName: aws.String(stackName),
+	}
+
+	err = svc.ListStackResourcesPages(
+		input,
+		func(page *cloudformation.ListStackResourcesOutput, lastPage bool) bool {
+			for _, resource := range page.StackResourceSummaries {
+				d.StreamListItem(ctx, resource)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !lastPage
+		},
+	)
+	return nil, err
+}
@@ -0,0 +1,302 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCloudFormationStackSet(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudformation_stack_set",
+		Description: "AWS CloudFormation Stack Set",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"StackSetNotFoundException", "ValidationError"}),
+			},
+			Hydrate: getCloudFormationStackSet,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCloudFormationStackSets,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "status", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name that's associated with the stack set.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StackSetName"),
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the stack set.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StackSetId"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the stack set.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudFormationStackSet,
+				Transform:   transform.FromField("StackSetARN"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the stack set, either ACTIVE or DELETED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "A description of the stack set that you specify when the stack set is created or updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "permission_model",
+				Description: "Describes how the IAM roles required for stack set operations are created, either SELF_MANAGED or SERVICE_MANAGED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "drift_status",
+				Description: "Status of the stack set's actual configuration compared to its expected template and parameter configuration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DriftStatus", "StackSetDriftDetectionDetails.DriftStatus"),
+			},
+			{
+				Name:        "last_drift_check_timestamp",
+				Description: "Most recent time when CloudFormation performed a drift detection operation on the stack set.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LastDriftCheckTimestamp", "StackSetDriftDetectionDetails.LastDriftCheckTimestamp"),
+			},
+			{
+				Name:        "administration_role_arn",
+				Description: "The Amazon Resource Name (ARN) of the IAM role used to create or update the stack set.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudFormationStackSet,
+				Transform:   transform.FromField("AdministrationRoleARN"),
+			},
+			{
+				Name:        "execution_role_name",
+				Description: "The name of the IAM execution role used to create or update the stack set.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudFormationStackSet,
+			},
+			{
+				Name:        "auto_deployment",
+				Description: "Describes whether StackSets automatically deploys to Organizations accounts that are added to a target organization or organizational unit (OU).",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "capabilities",
+				Description: "The capabilities that are allowed in the stack set.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudFormationStackSet,
+			},
+			{
+				Name:        "drift_detection_details",
+				Description: "Detailed information about the drift status of the stack set.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudFormationStackSet,
+				Transform:   transform.FromField("StackSetDriftDetectionDetails"),
+			},
+			{
+				Name:        "organizational_unit_ids",
+				Description: "The organization root ID or organizational unit (OU) IDs that you specified for the stack set deployment targets.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudFormationStackSet,
+			},
+			{
+				Name:        "parameters",
+				Description: "A list of input parameters for the stack set template.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudFormationStackSet,
+			},
+			{
+				Name:        "stack_instances",
+				Description: "A list of the stack instances that are associated with the stack set.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listCloudFormationStackSetInstances,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "template_body",
+				Description: "The structure that contains the body of the template that was used to create or update the stack set.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudFormationStackSet,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags associated with the stack set.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudFormationStackSet,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudFormationStackSet,
+				Transform:   transform.FromField("Tags").Transform(cfnStackSetTagsToTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StackSetName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudFormationStackSet,
+				Transform:   transform.FromField("StackSetARN").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudFormationStackSets(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CloudFormationService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &cloudformation.ListStackSetsInput{
+		MaxResults: aws.Int64(100),
+	}
+
+	// Additonal Filter
+	equalQuals := d.KeyColumnQuals
+	if equalQuals["status"] != nil {
+		input.Status = aws.String(equalQuals["status"].GetStringValue())
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxResults {
+			if *limit < 1 {
+				input.MaxResults = aws.Int64(1)
+			} else {
+				input.MaxResults = limit
+			}
+		}
+	}
+
+	err = svc.ListStackSetsPages(
+		input,
+		func(page *cloudformation.ListStackSetsOutput, lastPage bool) bool {
+			for _, stackSet := range page.Summaries {
+				d.StreamListItem(ctx, stackSet)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !lastPage
+		},
+	)
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCloudFormationStackSet(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getCloudFormationStackSet")
+
+	var name string
+	if h.Item != nil {
+		name = *h.Item.(*cloudformation.StackSetSummary).StackSetName
+	} else {
+		name = d.KeyColumnQuals["name"].GetStringValue()
+	}
+
+	// Create Session
+	svc, err := CloudFormationService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &cloudformation.DescribeStackSetInput{
+		StackSetName: aws.String(name),
+	}
+
+	op, err := svc.DescribeStackSet(params)
+	if err != nil {
+		plugin.Logger(ctx).Debug("getCloudFormationStackSet", "ERROR", err)
+		return nil, err
+	}
+
+	return op.StackSet, nil
+}
+
+func listCloudFormationStackSetInstances(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listCloudFormationStackSetInstances")
+
+	var name *string
+	switch item := h.Item.(type) {
+	case *cloudformation.StackSetSummary:
+		name = item.StackSetName
+	case *cloudformation.StackSet:
+		name = item.StackSetName
+	}
+
+	// Create Session
+	svc, err := CloudFormationService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &cloudformation.ListStackInstancesInput{
+		StackSetName: name,
+		MaxResults:   aws.Int64(100),
+	}
+
+	var instances []*cloudformation.StackInstanceSummary
+	err = svc.ListStackInstancesPages(
+		params,
+		func(page *cloudformation.ListStackInstancesOutput, lastPage bool) bool {
+			instances = append(instances, page.Summaries...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Debug("listCloudFormationStackSetInstances", "ERROR", err)
+		return nil, err
+	}
+
+	return instances, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func cfnStackSetTagsToTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.Value.([]*cloudformation.Tag)
+	if !ok || len(tags) == 0 {
+		return nil, nil
+	}
+
+	turbotTagsMap := map[string]string{}
+	for _, i := range tags {
+		turbotTagsMap[*i.Key] = *i.Value
+	}
+
+	return turbotTagsMap, nil
+}
@@ -26,6 +26,9 @@ func tableAwsCloudFrontCachePolicy(_ context.Context) *plugin.Table {
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCloudFrontCachePolicies,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "type", Require: plugin.Optional},
+			},
 		},
 		Columns: awsColumns([]*plugin.Column{
 			{
@@ -46,6 +49,11 @@ func tableAwsCloudFrontCachePolicy(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("CachePolicy.CachePolicyConfig.Comment"),
 			},
+			{
+				Name:        "type",
+				Description: "The type of cache policy, either managed (created by AWS) or custom (created in this AWS account).",
+				Type:        proto.ColumnType_STRING,
+			},
 			{
 				Name:        "default_ttl",
 				Description: "The default amount of time, in seconds, that you want objects to stay in the CloudFront cache before CloudFront sends another request to the origin to see if the object has been updated.",
@@ -120,6 +128,10 @@ func listCloudFrontCachePolicies(ctx context.Context, d *plugin.QueryData, _ *pl
 		MaxItems: aws.Int64(1000),
 	}
 
+	if d.KeyColumnQuals["type"] != nil {
+		input.Type = aws.String(d.KeyColumnQuals["type"].GetStringValue())
+	}
+
 	// If the requested number of items is less than the paging max limit
 	// set the limit to that instead
 	limit := d.QueryContext.Limit
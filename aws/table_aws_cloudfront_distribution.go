@@ -209,7 +209,7 @@ func tableAwsCloudFrontDistribution(_ context.Context) *plugin.Table {
 				Name:        "title",
 				Description: resourceInterfaceDescription("title"),
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id"),
+				Transform:   transform.FromField("Id", "Distribution.Id"),
 			},
 			{
 				Name:        "tags",
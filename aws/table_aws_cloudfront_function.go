@@ -0,0 +1,252 @@
+package aws
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCloudFrontFunction(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudfront_function",
+		Description: "AWS CloudFront Function",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "stage"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"NoSuchFunctionExists"}),
+			},
+			Hydrate: getCloudFrontFunction,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCloudFrontFunctions,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "stage", Require: plugin.Optional},
+			},
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the CloudFront function.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name", "FunctionSummary.Name"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the function.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FunctionMetadata.FunctionARN", "FunctionSummary.FunctionMetadata.FunctionARN"),
+			},
+			{
+				Name:        "stage",
+				Description: "The stage that the function is in, either DEVELOPMENT or LIVE.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FunctionMetadata.Stage", "FunctionSummary.FunctionMetadata.Stage"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the CloudFront function.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status", "FunctionSummary.Status"),
+			},
+			{
+				Name:        "comment",
+				Description: "A comment to describe the function.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FunctionConfig.Comment", "FunctionSummary.FunctionConfig.Comment"),
+			},
+			{
+				Name:        "runtime",
+				Description: "The function's runtime environment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FunctionConfig.Runtime", "FunctionSummary.FunctionConfig.Runtime"),
+			},
+			{
+				Name:        "created_time",
+				Description: "The date and time when the function was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("FunctionMetadata.CreatedTime", "FunctionSummary.FunctionMetadata.CreatedTime"),
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "The date and time when the function was most recently updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("FunctionMetadata.LastModifiedTime", "FunctionSummary.FunctionMetadata.LastModifiedTime"),
+			},
+			{
+				Name:        "etag",
+				Description: "The version identifier for the current version of the CloudFront function.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudFrontFunction,
+				Transform:   transform.FromField("ETag"),
+			},
+			{
+				Name:        "code",
+				Description: "The base64-encoded function code.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudFrontFunctionCode,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name", "FunctionSummary.Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("FunctionMetadata.FunctionARN", "FunctionSummary.FunctionMetadata.FunctionARN").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudFrontFunctions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listCloudFrontFunctions")
+
+	// Create session
+	svc, err := CloudFrontService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// List each stage separately so that a function published to LIVE
+	// is returned once for its DEVELOPMENT stage and once for its LIVE stage
+	stages := []string{cloudfront.FunctionStageDevelopment, cloudfront.FunctionStageLive}
+	if d.KeyColumnQuals["stage"] != nil {
+		stages = []string{d.KeyColumnQuals["stage"].GetStringValue()}
+	}
+
+	for _, stage := range stages {
+		// The maximum number for MaxItems parameter is not defined by the API
+		// We have set the MaxItems to 1000 based on our test
+		input := &cloudfront.ListFunctionsInput{
+			MaxItems: aws.Int64(1000),
+			Stage:    aws.String(stage),
+		}
+
+		// If the requested number of items is less than the paging max limit
+		// set the limit to that instead
+		limit := d.QueryContext.Limit
+		if d.QueryContext.Limit != nil {
+			if *limit < *input.MaxItems {
+				if *limit < 1 {
+					input.MaxItems = types.Int64(1)
+				} else {
+					input.MaxItems = limit
+				}
+			}
+		}
+
+		pagesLeft := true
+		for pagesLeft {
+			result, err := svc.ListFunctions(input)
+			if err != nil {
+				plugin.Logger(ctx).Error("listCloudFrontFunctions", "ListFunctions_error", err)
+				return nil, err
+			}
+			for _, function := range result.FunctionList.Items {
+				d.StreamListItem(ctx, function)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+			if result.FunctionList.NextMarker != nil {
+				input.Marker = result.FunctionList.NextMarker
+			} else {
+				pagesLeft = false
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCloudFrontFunction(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getCloudFrontFunction")
+
+	// Create session
+	svc, err := CloudFrontService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	var name, stage string
+	if h.Item != nil {
+		name, stage = cloudFrontFunctionNameAndStage(h.Item)
+	} else {
+		name = d.KeyColumnQuals["name"].GetStringValue()
+		stage = d.KeyColumnQuals["stage"].GetStringValue()
+	}
+
+	params := &cloudfront.DescribeFunctionInput{
+		Name:  aws.String(name),
+		Stage: aws.String(stage),
+	}
+
+	op, err := svc.DescribeFunction(params)
+	if err != nil {
+		plugin.Logger(ctx).Error("getCloudFrontFunction", "DescribeFunction_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getCloudFrontFunctionCode(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getCloudFrontFunctionCode")
+
+	// Create session
+	svc, err := CloudFrontService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	name, stage := cloudFrontFunctionNameAndStage(h.Item)
+
+	params := &cloudfront.GetFunctionInput{
+		Name:  aws.String(name),
+		Stage: aws.String(stage),
+	}
+
+	op, err := svc.GetFunction(params)
+	if err != nil {
+		plugin.Logger(ctx).Error("getCloudFrontFunctionCode", "GetFunction_error", err)
+		return nil, err
+	}
+
+	return base64.StdEncoding.EncodeToString(op.FunctionCode), nil
+}
+
+//// UTILITY FUNCTIONS
+
+func cloudFrontFunctionNameAndStage(item interface{}) (string, string) {
+	var summary *cloudfront.FunctionSummary
+	switch item := item.(type) {
+	case *cloudfront.DescribeFunctionOutput:
+		summary = item.FunctionSummary
+	case *cloudfront.FunctionSummary:
+		summary = item
+	}
+	if summary == nil || summary.FunctionMetadata == nil {
+		return "", ""
+	}
+	return types.SafeString(summary.Name), types.SafeString(summary.FunctionMetadata.Stage)
+}
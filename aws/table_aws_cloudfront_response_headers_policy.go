@@ -0,0 +1,223 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCloudFrontResponseHeadersPolicy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudfront_response_headers_policy",
+		Description: "AWS CloudFront Response Headers Policy",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"NoSuchResponseHeadersPolicy"}),
+			},
+			Hydrate: getCloudFrontResponseHeadersPolicy,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCloudFrontResponseHeadersPolicies,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "type", Require: plugin.Optional},
+			},
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "A unique name to identify the response headers policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResponseHeadersPolicy.ResponseHeadersPolicyConfig.Name"),
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier for the response headers policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResponseHeadersPolicy.Id"),
+			},
+			{
+				Name:        "type",
+				Description: "The type of response headers policy, either managed (created by AWS) or custom (created in this AWS account).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "comment",
+				Description: "A comment to describe the response headers policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResponseHeadersPolicy.ResponseHeadersPolicyConfig.Comment"),
+			},
+			{
+				Name:        "etag",
+				Description: "The current version of the response headers policy.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudFrontResponseHeadersPolicy,
+				Transform:   transform.FromField("ETag"),
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "The date and time when the response headers policy was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ResponseHeadersPolicy.LastModifiedTime"),
+			},
+			{
+				Name:        "cors_config",
+				Description: "A configuration for a set of HTTP response headers that are used for cross-origin resource sharing (CORS).",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ResponseHeadersPolicy.ResponseHeadersPolicyConfig.CorsConfig"),
+			},
+			{
+				Name:        "custom_headers_config",
+				Description: "A configuration for a set of custom HTTP response headers.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ResponseHeadersPolicy.ResponseHeadersPolicyConfig.CustomHeadersConfig"),
+			},
+			{
+				Name:        "security_headers_config",
+				Description: "A configuration for a set of security-related HTTP response headers.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ResponseHeadersPolicy.ResponseHeadersPolicyConfig.SecurityHeadersConfig"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResponseHeadersPolicy.ResponseHeadersPolicyConfig.Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudFrontResponseHeadersPolicyAkas,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudFrontResponseHeadersPolicies(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listCloudFrontResponseHeadersPolicies")
+
+	// Create session
+	svc, err := CloudFrontService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// The maximum number for MaxItems parameter is not defined by the API
+	// We have set the MaxItems to 1000 based on our test
+	input := &cloudfront.ListResponseHeadersPoliciesInput{
+		MaxItems: aws.Int64(1000),
+	}
+
+	if d.KeyColumnQuals["type"] != nil {
+		input.Type = aws.String(d.KeyColumnQuals["type"].GetStringValue())
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxItems {
+			if *limit < 1 {
+				input.MaxItems = types.Int64(1)
+			} else {
+				input.MaxItems = limit
+			}
+		}
+	}
+
+	// List call
+	pagesLeft := true
+	for pagesLeft {
+		result, err := svc.ListResponseHeadersPolicies(input)
+		if err != nil {
+			plugin.Logger(ctx).Error("listCloudFrontResponseHeadersPolicies", "ListResponseHeadersPolicies_error", err)
+			return nil, err
+		}
+		for _, policy := range result.ResponseHeadersPolicyList.Items {
+			d.StreamListItem(ctx, policy)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if result.ResponseHeadersPolicyList.NextMarker != nil {
+			input.Marker = result.ResponseHeadersPolicyList.NextMarker
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCloudFrontResponseHeadersPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getCloudFrontResponseHeadersPolicy")
+
+	// Create session
+	svc, err := CloudFrontService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	var id string
+	if h.Item != nil {
+		id = *h.Item.(*cloudfront.ResponseHeadersPolicySummary).ResponseHeadersPolicy.Id
+	} else {
+		id = d.KeyColumnQuals["id"].GetStringValue()
+	}
+
+	params := &cloudfront.GetResponseHeadersPolicyInput{
+		Id: aws.String(id),
+	}
+
+	op, err := svc.GetResponseHeadersPolicy(params)
+	if err != nil {
+		plugin.Logger(ctx).Error("getCloudFrontResponseHeadersPolicy", "GetResponseHeadersPolicy_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getCloudFrontResponseHeadersPolicyAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getCloudFrontResponseHeadersPolicyAkas")
+	id := cloudFrontResponseHeadersPolicyAka(h.Item)
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	akas := []string{"arn:" + commonColumnData.Partition + ":cloudfront::" + commonColumnData.AccountId + ":response-headers-policy/" + *id}
+
+	return akas, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func cloudFrontResponseHeadersPolicyAka(item interface{}) *string {
+	switch item := item.(type) {
+	case *cloudfront.GetResponseHeadersPolicyOutput:
+		return item.ResponseHeadersPolicy.Id
+	case *cloudfront.ResponseHeadersPolicySummary:
+		return item.ResponseHeadersPolicy.Id
+	}
+	return nil
+}
@@ -55,6 +55,7 @@ func tableAwsCloudtrailTrailEvent(_ context.Context) *plugin.Table {
 			{Name: "user_type", Type: proto.ColumnType_STRING, Hydrate: getCloudtrailMessageField, Transform: transform.FromField("UserIdentity.Type"), Description: "The name of the event returned."},
 			{Name: "username", Type: proto.ColumnType_STRING, Hydrate: getCloudtrailMessageField, Transform: transform.FromField("UserIdentity.Username"), Description: "The user name of the user that made the api request."},
 			{Name: "user_identifier", Type: proto.ColumnType_STRING, Hydrate: getCloudtrailMessageField, Transform: transform.FromField("UserIdentity.Arn", "UserIdentity.SessionContext.sessionIssuer.arn", "UserIdentity.SessionContext.sessionIssuer.principalId"), Description: "The name/arn of user/role that made the api call."},
+			{Name: "user_identity_arn", Type: proto.ColumnType_STRING, Hydrate: getCloudtrailMessageField, Transform: transform.FromField("UserIdentity.Arn"), Description: "The Amazon Resource Name (ARN) of the principal that made the api call."},
 			{Name: "vpc_endpoint_id", Type: proto.ColumnType_STRING, Hydrate: getCloudtrailMessageField, Description: "Identifies the VPC endpoint in which requests were made from a VPC to another AWS service, such as Amazon S3."},
 
 			// Json fields
@@ -267,16 +268,21 @@ func listCloudwatchLogTrailEvents(ctx context.Context, d *plugin.QueryData, _ *p
 
 	if quals["timestamp"] != nil {
 		for _, q := range quals["timestamp"].Quals {
-			tsSecs := q.Value.GetTimestampValue().GetSeconds()
-			tsMs := tsSecs * 1000
+			tsMs := q.Value.GetTimestampValue().AsTime().UnixNano() / int64(time.Millisecond)
+			// StartTime and EndTime are both inclusive, so strict bounds are
+			// narrowed by a millisecond
 			switch q.Operator {
 			case "=":
 				input.StartTime = aws.Int64(tsMs)
 				input.EndTime = aws.Int64(tsMs)
-			case ">=", ">":
+			case ">=":
 				input.StartTime = aws.Int64(tsMs)
-			case "<", "<=":
+			case ">":
+				input.StartTime = aws.Int64(tsMs + 1)
+			case "<=":
 				input.EndTime = aws.Int64(tsMs)
+			case "<":
+				input.EndTime = aws.Int64(tsMs - 1)
 			}
 		}
 	}
@@ -287,7 +293,7 @@ func listCloudwatchLogTrailEvents(ctx context.Context, d *plugin.QueryData, _ *p
 
 	err = svc.FilterLogEventsPages(
 		&input,
-		func(page *cloudwatchlogs.FilterLogEventsOutput, _ bool) bool {
+		func(page *cloudwatchlogs.FilterLogEventsOutput, isLast bool) bool {
 			for _, logEvent := range page.Events {
 				d.StreamListItem(ctx, logEvent)
 
@@ -301,7 +307,7 @@ func listCloudwatchLogTrailEvents(ctx context.Context, d *plugin.QueryData, _ *p
 			case <-ctx.Done():
 				return false
 			default:
-				return true
+				return !isLast
 			}
 		},
 	)
@@ -345,6 +351,11 @@ func buildQueryFilter(equalQuals plugin.KeyColumnEqualsQualMap) []string {
 
 	for qual, filterKey := range filterQuals {
 		if equalQuals[qual] != nil {
+			// Boolean fields use the IS TRUE/IS FALSE filter syntax instead of string comparison
+			if qual == "read_only" {
+				filters = append(filters, fmt.Sprintf("( $.%s IS %s )", filterKey, strings.ToUpper(fmt.Sprint(equalQuals[qual].GetBoolValue()))))
+				continue
+			}
 			filters = append(filters, fmt.Sprintf("( $.%s = \"%s\" )", filterKey, equalQuals[qual].GetStringValue()))
 		}
 	}
@@ -0,0 +1,32 @@
+package aws
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+func TestBuildQueryFilter(t *testing.T) {
+	equalQuals := plugin.KeyColumnEqualsQualMap{
+		"event_source": &proto.QualValue{Value: &proto.QualValue_StringValue{StringValue: "iam.amazonaws.com"}},
+		"read_only":    &proto.QualValue{Value: &proto.QualValue_BoolValue{BoolValue: false}},
+	}
+
+	filters := buildQueryFilter(equalQuals)
+	sort.Strings(filters)
+
+	expected := []string{
+		`( $.eventSource = "iam.amazonaws.com" )`,
+		`( $.readOnly IS FALSE )`,
+	}
+	if len(filters) != len(expected) {
+		t.Fatalf("expected %d filters, got %d: %v", len(expected), len(filters), filters)
+	}
+	for i := range expected {
+		if filters[i] != expected[i] {
+			t.Errorf("expected filter %q, got %q", expected[i], filters[i])
+		}
+	}
+}
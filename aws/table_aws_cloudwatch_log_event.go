@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -19,6 +20,7 @@ func tableAwsCloudwatchLogEventListKeyColumns() []*plugin.KeyColumn {
 	return []*plugin.KeyColumn{
 		{Name: "log_group_name"},
 		{Name: "log_stream_name", Require: plugin.Optional},
+		{Name: "log_stream_name_prefix", Require: plugin.Optional},
 		{Name: "filter", Require: plugin.Optional, CacheMatch: "exact"},
 		{Name: "region", Require: plugin.Optional},
 		{Name: "timestamp", Operators: []string{">", ">=", "=", "<", "<="}, Require: plugin.Optional},
@@ -38,6 +40,7 @@ func tableAwsCloudwatchLogEvent(_ context.Context) *plugin.Table {
 			// Top columns
 			{Name: "log_group_name", Type: proto.ColumnType_STRING, Transform: transform.FromQual("log_group_name"), Description: "The name of the log group to which this event belongs."},
 			{Name: "log_stream_name", Type: proto.ColumnType_STRING, Description: "The name of the log stream to which this event belongs."},
+			{Name: "log_stream_name_prefix", Type: proto.ColumnType_STRING, Transform: transform.FromQual("log_stream_name_prefix"), Description: "The prefix to match log stream names against. Only populated when specified in the where clause."},
 			{Name: "event_id", Type: proto.ColumnType_STRING, Description: "The ID of the event."},
 			{Name: "timestamp", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Timestamp").Transform(transform.UnixMsToTimestamp), Description: "The time when the event occurred."},
 			{Name: "ingestion_time", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("IngestionTime").Transform(transform.UnixMsToTimestamp), Description: "The time when the event was ingested."},
@@ -82,8 +85,11 @@ func listCloudwatchLogEvents(ctx context.Context, d *plugin.QueryData, _ *plugin
 		}
 	}
 
+	// LogStreamNames and LogStreamNamePrefix can not be passed together
 	if equalQuals["log_stream_name"] != nil {
 		input.LogStreamNames = []*string{aws.String(equalQuals["log_stream_name"].GetStringValue())}
+	} else if equalQuals["log_stream_name_prefix"] != nil {
+		input.LogStreamNamePrefix = aws.String(equalQuals["log_stream_name_prefix"].GetStringValue())
 	}
 
 	if equalQuals["filter"] != nil {
@@ -102,14 +108,15 @@ func listCloudwatchLogEvents(ctx context.Context, d *plugin.QueryData, _ *plugin
 
 	if quals["timestamp"] != nil {
 		for _, q := range quals["timestamp"].Quals {
-			tsSecs := q.Value.GetTimestampValue().GetSeconds()
-			tsMs := tsSecs * 1000
+			tsMs := q.Value.GetTimestampValue().AsTime().UnixNano() / int64(time.Millisecond)
 			switch q.Operator {
 			case "=":
 				input.StartTime = aws.Int64(tsMs)
 				input.EndTime = aws.Int64(tsMs)
-			case ">=", ">":
+			case ">=":
 				input.StartTime = aws.Int64(tsMs)
+			case ">":
+				input.StartTime = aws.Int64(tsMs + 1)
 			case "<", "<=":
 				input.EndTime = aws.Int64(tsMs)
 			}
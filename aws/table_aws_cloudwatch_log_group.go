@@ -27,6 +27,10 @@ func tableAwsCloudwatchLogGroup(_ context.Context) *plugin.Table {
 					Name:    "name",
 					Require: plugin.Optional,
 				},
+				{
+					Name:    "name_prefix",
+					Require: plugin.Optional,
+				},
 			},
 		},
 		GetMatrixItem: BuildRegionList,
@@ -37,6 +41,12 @@ func tableAwsCloudwatchLogGroup(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("LogGroupName"),
 			},
+			{
+				Name:        "name_prefix",
+				Description: "The prefix to match log group names against. Only populated when specified in the where clause.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("name_prefix"),
+			},
 			{
 				Name:        "arn",
 				Description: "The Amazon Resource Name (ARN) of the log group.",
@@ -68,6 +78,13 @@ func tableAwsCloudwatchLogGroup(_ context.Context) *plugin.Table {
 				Description: "The number of bytes stored.",
 				Type:        proto.ColumnType_INT,
 			},
+			{
+				Name:        "subscription_filters",
+				Description: "The subscription filters associated with the log group.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listLogGroupSubscriptionFilters,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "title",
 				Description: resourceInterfaceDescription("title"),
@@ -107,6 +124,8 @@ func listCloudwatchLogGroups(ctx context.Context, d *plugin.QueryData, _ *plugin
 	equalQuals := d.KeyColumnQuals
 	if equalQuals["name"] != nil {
 		input.LogGroupNamePrefix = types.String(equalQuals["name"].GetStringValue())
+	} else if equalQuals["name_prefix"] != nil {
+		input.LogGroupNamePrefix = types.String(equalQuals["name_prefix"].GetStringValue())
 	}
 
 	// If the requested number of items is less than the paging max limit
@@ -192,3 +211,31 @@ func getLogGroupTagging(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 	}
 	return logGroupData, nil
 }
+
+func listLogGroupSubscriptionFilters(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listLogGroupSubscriptionFilters")
+	logGroup := h.Item.(*cloudwatchlogs.LogGroup)
+
+	// Create session
+	svc, err := CloudWatchLogsService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &cloudwatchlogs.DescribeSubscriptionFiltersInput{
+		LogGroupName: logGroup.LogGroupName,
+	}
+
+	var filters []*cloudwatchlogs.SubscriptionFilter
+	err = svc.DescribeSubscriptionFiltersPages(
+		params,
+		func(page *cloudwatchlogs.DescribeSubscriptionFiltersOutput, isLast bool) bool {
+			filters = append(filters, page.SubscriptionFilters...)
+			return !isLast
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return filters, nil
+}
@@ -35,6 +35,10 @@ func tableAwsCloudwatchLogStream(_ context.Context) *plugin.Table {
 					Name:    "name",
 					Require: plugin.Optional,
 				},
+				{
+					Name:    "log_group_name",
+					Require: plugin.Optional,
+				},
 			},
 		},
 		GetMatrixItem: BuildRegionList,
@@ -81,6 +85,12 @@ func tableAwsCloudwatchLogStream(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_TIMESTAMP,
 				Transform:   transform.FromField("LogStream.LastIngestionTime").Transform(transform.UnixMsToTimestamp),
 			},
+			{
+				Name:        "stored_bytes",
+				Description: "The number of bytes stored. This value is no longer updated by CloudWatch Logs and is always 0 for newer log streams.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("LogStream.StoredBytes"),
+			},
 			{
 				Name:        "upload_sequence_token",
 				Description: "Specifies the log upload sequence token.",
@@ -109,6 +119,11 @@ func listCloudwatchLogStreams(ctx context.Context, d *plugin.QueryData, h *plugi
 	// Get logGroup details
 	logGroup := h.Item.(*cloudwatchlogs.LogGroup)
 
+	// Minimize the API call with the given log group name
+	if d.KeyColumnQuals["log_group_name"] != nil && d.KeyColumnQuals["log_group_name"].GetStringValue() != *logGroup.LogGroupName {
+		return nil, nil
+	}
+
 	// Create session
 	svc, err := CloudWatchLogsService(ctx, d)
 	if err != nil {
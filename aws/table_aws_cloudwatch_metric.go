@@ -63,6 +63,11 @@ func tableAwsCloudWatchMetric(_ context.Context) *plugin.Table {
 				Description: "The dimension value for the metric.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "dimensions",
+				Description: "The full set of dimensions of the metric, which identifies it in aws_cloudwatch_metric_statistic_data_point.",
+				Type:        proto.ColumnType_JSON,
+			},
 
 			// Steampipe standard columns
 			{
@@ -80,6 +85,7 @@ type MetricDetails struct {
 	Namespace      string
 	DimensionName  string
 	DimensionValue string
+	Dimensions     []*cloudwatch.Dimension
 }
 
 //// LIST FUNCTION
@@ -135,15 +141,15 @@ func listCloudWatchMetrics(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 							Namespace:      *metricDetail.Namespace,
 							DimensionName:  *dimension.Name,
 							DimensionValue: *dimension.Value,
+							Dimensions:     metricDetail.Dimensions,
 						})
 					}
-
-					// Context can be cancelled due to manual cancellation or the limit has been hit
-					if d.QueryStatus.RowsRemaining(ctx) == 0 {
-						return false
-					}
 				}
 
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
 			}
 			return !isLast
 		},
@@ -0,0 +1,188 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+type cwMetricStatisticDataPointRow struct {
+	CWMetricRow
+	Dimensions []*cloudwatch.Dimension
+	Period     *int64
+}
+
+//// TABLE DEFINITION
+
+func tableAwsCloudWatchMetricStatisticDataPoint(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudwatch_metric_statistic_data_point",
+		Description: "AWS CloudWatch Metric Statistic Data Point",
+		List: &plugin.ListConfig{
+			Hydrate: listCloudWatchMetricStatisticDataPoints,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "namespace", Require: plugin.Required},
+				{Name: "metric_name", Require: plugin.Required},
+				{Name: "dimensions", Require: plugin.Optional},
+				{Name: "period", Require: plugin.Optional},
+				{Name: "unit", Require: plugin.Optional},
+				{Name: "timestamp", Require: plugin.Optional, Operators: []string{">", ">=", "=", "<", "<="}},
+			},
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns(cwMetricColumns(
+			[]*plugin.Column{
+				{
+					Name:        "dimensions",
+					Description: "The dimensions of the metric, e.g. [{\"Name\": \"InstanceId\", \"Value\": \"i-1234567890abcdef0\"}]. Metrics with dimensions are only returned if all of their dimensions are specified.",
+					Type:        proto.ColumnType_JSON,
+				},
+				{
+					Name:        "period",
+					Description: "The granularity, in seconds, of the returned data points. Defaults to 300.",
+					Type:        proto.ColumnType_INT,
+				},
+				{
+					Name:        "title",
+					Description: resourceInterfaceDescription("title"),
+					Type:        proto.ColumnType_STRING,
+					Transform:   transform.FromField("MetricName"),
+				},
+			})),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudWatchMetricStatisticDataPoints(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listCloudWatchMetricStatisticDataPoints")
+
+	equalQuals := d.KeyColumnQuals
+	namespace := equalQuals["namespace"].GetStringValue()
+	metricName := equalQuals["metric_name"].GetStringValue()
+
+	// Empty check
+	if namespace == "" || metricName == "" {
+		return nil, nil
+	}
+
+	period := getCWPeriodForGranularity("5_MIN")
+	if equalQuals["period"] != nil {
+		period = equalQuals["period"].GetInt64Value()
+	}
+
+	var dimensions []*cloudwatch.Dimension
+	if equalQuals["dimensions"] != nil {
+		err := json.Unmarshal([]byte(equalQuals["dimensions"].GetJsonbValue()), &dimensions)
+		if err != nil {
+			plugin.Logger(ctx).Error("listCloudWatchMetricStatisticDataPoints", "dimensions_unmarshal_error", err)
+			return nil, err
+		}
+	}
+
+	// Without a timestamp range, fetch as many periods as a single
+	// GetMetricStatistics call returns, within the retention of the period
+	endTime := time.Now()
+	startTime := getCWMetricStatisticDefaultStartTime(endTime, period)
+
+	if d.Quals["timestamp"] != nil {
+		for _, q := range d.Quals["timestamp"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case "=":
+				startTime = timestamp
+				endTime = timestamp.Add(time.Second)
+			case ">", ">=":
+				startTime = timestamp
+			case "<", "<=":
+				endTime = timestamp
+			}
+		}
+	}
+
+	// Create Session
+	svc, err := CloudWatchService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &cloudwatch.GetMetricStatisticsInput{
+		Namespace:  aws.String(namespace),
+		MetricName: aws.String(metricName),
+		Dimensions: dimensions,
+		StartTime:  aws.Time(startTime),
+		EndTime:    aws.Time(endTime),
+		Period:     aws.Int64(period),
+		Statistics: []*string{
+			aws.String("Average"),
+			aws.String("SampleCount"),
+			aws.String("Sum"),
+			aws.String("Minimum"),
+			aws.String("Maximum"),
+		},
+	}
+	if equalQuals["unit"] != nil {
+		params.Unit = aws.String(equalQuals["unit"].GetStringValue())
+	}
+
+	stats, err := svc.GetMetricStatistics(params)
+	if err != nil {
+		plugin.Logger(ctx).Error("listCloudWatchMetricStatisticDataPoints", "GetMetricStatistics_error", err)
+		return nil, err
+	}
+
+	for _, datapoint := range stats.Datapoints {
+		d.StreamListItem(ctx, &cwMetricStatisticDataPointRow{
+			CWMetricRow: CWMetricRow{
+				Namespace:   aws.String(namespace),
+				MetricName:  aws.String(metricName),
+				Average:     datapoint.Average,
+				Maximum:     datapoint.Maximum,
+				Minimum:     datapoint.Minimum,
+				Timestamp:   datapoint.Timestamp,
+				SampleCount: datapoint.SampleCount,
+				Sum:         datapoint.Sum,
+				Unit:        datapoint.Unit,
+			},
+			Dimensions: dimensions,
+			Period:     aws.Int64(period),
+		})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// getCWMetricStatisticDefaultStartTime returns the start of a window holding
+// at most 1440 data points of the given period, the GetMetricStatistics limit,
+// capped at how long CloudWatch retains data points of that period
+func getCWMetricStatisticDefaultStartTime(endTime time.Time, period int64) time.Time {
+	var retention time.Duration
+	switch {
+	case period < 60:
+		// high resolution data points
+		retention = 3 * time.Hour
+	case period < 300:
+		retention = 15 * 24 * time.Hour
+	case period < 3600:
+		retention = 63 * 24 * time.Hour
+	default:
+		retention = 455 * 24 * time.Hour
+	}
+
+	window := time.Duration(1440*period) * time.Second
+	if window > retention {
+		window = retention
+	}
+	return endTime.Add(-window)
+}
@@ -0,0 +1,266 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/codeartifact"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCodeArtifactDomain(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_codeartifact_domain",
+		Description: "AWS CodeArtifact Domain",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getCodeArtifactDomain,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCodeArtifactDomains,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the domain.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the domain.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "owner",
+				Description: "The Amazon Web Services account ID that owns the domain.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the domain, either Active or Deleted.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_time",
+				Description: "A timestamp that represents the date and time the domain was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "encryption_key",
+				Description: "The ARN of an Key Management Service (KMS) key associated with the domain.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "asset_size_bytes",
+				Description: "The total size of all assets in the domain.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getCodeArtifactDomain,
+			},
+			{
+				Name:        "repository_count",
+				Description: "The number of repositories in the domain.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getCodeArtifactDomain,
+			},
+			{
+				Name:        "s3_bucket_arn",
+				Description: "The Amazon Resource Name (ARN) of the Amazon S3 bucket that is used to store package assets in the domain.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCodeArtifactDomain,
+			},
+			{
+				Name:        "policy",
+				Description: "The resource policy that grants permissions on the domain.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCodeArtifactDomainPermissionsPolicy,
+				Transform:   transform.FromField("Document"),
+			},
+			{
+				Name:        "policy_std",
+				Description: "Contains the policy in a canonical form for easier searching.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCodeArtifactDomainPermissionsPolicy,
+				Transform:   transform.FromField("Document").Transform(policyToCanonical),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tag key and value pairs associated with this domain.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCodeArtifactDomainTags,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCodeArtifactDomainTags,
+				Transform:   transform.FromValue().Transform(codeArtifactTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCodeArtifactDomains(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CodeArtifactService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &codeartifact.ListDomainsInput{
+		MaxResults: aws.Int64(1000),
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxResults {
+			if *limit < 1 {
+				input.MaxResults = aws.Int64(1)
+			} else {
+				input.MaxResults = limit
+			}
+		}
+	}
+
+	// List call
+	err = svc.ListDomainsPages(
+		input,
+		func(page *codeartifact.ListDomainsOutput, isLast bool) bool {
+			for _, domain := range page.Domains {
+				d.StreamListItem(ctx, domain)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("listCodeArtifactDomains", "ListDomainsPages_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCodeArtifactDomain(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getCodeArtifactDomain")
+
+	var name, owner *string
+	if h.Item != nil {
+		name, owner = codeArtifactDomainKeys(h.Item)
+	} else {
+		name = aws.String(d.KeyColumnQuals["name"].GetStringValue())
+
+		// Empty check
+		if *name == "" {
+			return nil, nil
+		}
+	}
+
+	// Create session
+	svc, err := CodeArtifactService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the params
+	params := &codeartifact.DescribeDomainInput{
+		Domain:      name,
+		DomainOwner: owner,
+	}
+
+	op, err := svc.DescribeDomain(params)
+	if err != nil {
+		plugin.Logger(ctx).Debug("getCodeArtifactDomain", "ERROR", err)
+		return nil, err
+	}
+
+	return op.Domain, nil
+}
+
+func getCodeArtifactDomainPermissionsPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getCodeArtifactDomainPermissionsPolicy")
+
+	name, owner := codeArtifactDomainKeys(h.Item)
+
+	// Create session
+	svc, err := CodeArtifactService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the params
+	params := &codeartifact.GetDomainPermissionsPolicyInput{
+		Domain:      name,
+		DomainOwner: owner,
+	}
+
+	op, err := svc.GetDomainPermissionsPolicy(params)
+	if err != nil {
+		// A domain without a resource policy returns ResourceNotFoundException
+		if a, ok := err.(awserr.Error); ok {
+			if a.Code() == codeartifact.ErrCodeResourceNotFoundException {
+				return nil, nil
+			}
+		}
+		plugin.Logger(ctx).Error("getCodeArtifactDomainPermissionsPolicy", "GetDomainPermissionsPolicy_error", err)
+		return nil, err
+	}
+
+	return op.Policy, nil
+}
+
+func getCodeArtifactDomainTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getCodeArtifactDomainTags")
+
+	var arn *string
+	switch item := h.Item.(type) {
+	case *codeartifact.DomainSummary:
+		arn = item.Arn
+	case *codeartifact.DomainDescription:
+		arn = item.Arn
+	}
+
+	return listCodeArtifactResourceTags(ctx, d, arn)
+}
+
+func codeArtifactDomainKeys(item interface{}) (name, owner *string) {
+	switch item := item.(type) {
+	case *codeartifact.DomainSummary:
+		return item.Name, item.Owner
+	case *codeartifact.DomainDescription:
+		return item.Name, item.Owner
+	}
+	return nil, nil
+}
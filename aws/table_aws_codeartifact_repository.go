@@ -0,0 +1,299 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/codeartifact"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCodeArtifactRepository(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_codeartifact_repository",
+		Description: "AWS CodeArtifact Repository",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "domain_name"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getCodeArtifactRepository,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCodeArtifactRepositories,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the repository.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the repository.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "domain_name",
+				Description: "The name of the domain that contains the repository.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "domain_owner",
+				Description: "The 12-digit account number of the Amazon Web Services account that owns the domain that contains the repository.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "administrator_account",
+				Description: "The 12-digit account number of the Amazon Web Services account that manages the repository.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "A text description of the repository.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "external_connections",
+				Description: "An array of external connections associated with the repository, e.g. public:npmjs.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCodeArtifactRepository,
+			},
+			{
+				Name:        "upstreams",
+				Description: "A list of upstream repositories to associate with the repository.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCodeArtifactRepository,
+			},
+			{
+				Name:        "policy",
+				Description: "The resource policy that grants permissions on the repository.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCodeArtifactRepositoryPermissionsPolicy,
+				Transform:   transform.FromField("Document"),
+			},
+			{
+				Name:        "policy_std",
+				Description: "Contains the policy in a canonical form for easier searching.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCodeArtifactRepositoryPermissionsPolicy,
+				Transform:   transform.FromField("Document").Transform(policyToCanonical),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tag key and value pairs associated with this repository.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCodeArtifactRepositoryTags,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCodeArtifactRepositoryTags,
+				Transform:   transform.FromValue().Transform(codeArtifactTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCodeArtifactRepositories(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CodeArtifactService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &codeartifact.ListRepositoriesInput{
+		MaxResults: aws.Int64(1000),
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxResults {
+			if *limit < 1 {
+				input.MaxResults = aws.Int64(1)
+			} else {
+				input.MaxResults = limit
+			}
+		}
+	}
+
+	// List call
+	err = svc.ListRepositoriesPages(
+		input,
+		func(page *codeartifact.ListRepositoriesOutput, isLast bool) bool {
+			for _, repository := range page.Repositories {
+				d.StreamListItem(ctx, repository)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("listCodeArtifactRepositories", "ListRepositoriesPages_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCodeArtifactRepository(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getCodeArtifactRepository")
+
+	var domainName, domainOwner, name *string
+	if h.Item != nil {
+		domainName, domainOwner, name = codeArtifactRepositoryKeys(h.Item)
+	} else {
+		domainName = aws.String(d.KeyColumnQuals["domain_name"].GetStringValue())
+		name = aws.String(d.KeyColumnQuals["name"].GetStringValue())
+
+		// Empty check
+		if *domainName == "" || *name == "" {
+			return nil, nil
+		}
+	}
+
+	// Create session
+	svc, err := CodeArtifactService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the params
+	params := &codeartifact.DescribeRepositoryInput{
+		Domain:      domainName,
+		DomainOwner: domainOwner,
+		Repository:  name,
+	}
+
+	op, err := svc.DescribeRepository(params)
+	if err != nil {
+		plugin.Logger(ctx).Debug("getCodeArtifactRepository", "ERROR", err)
+		return nil, err
+	}
+
+	return op.Repository, nil
+}
+
+func getCodeArtifactRepositoryPermissionsPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getCodeArtifactRepositoryPermissionsPolicy")
+
+	domainName, domainOwner, name := codeArtifactRepositoryKeys(h.Item)
+
+	// Create session
+	svc, err := CodeArtifactService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the params
+	params := &codeartifact.GetRepositoryPermissionsPolicyInput{
+		Domain:      domainName,
+		DomainOwner: domainOwner,
+		Repository:  name,
+	}
+
+	op, err := svc.GetRepositoryPermissionsPolicy(params)
+	if err != nil {
+		// A repository without a resource policy returns ResourceNotFoundException
+		if a, ok := err.(awserr.Error); ok {
+			if a.Code() == codeartifact.ErrCodeResourceNotFoundException {
+				return nil, nil
+			}
+		}
+		plugin.Logger(ctx).Error("getCodeArtifactRepositoryPermissionsPolicy", "GetRepositoryPermissionsPolicy_error", err)
+		return nil, err
+	}
+
+	return op.Policy, nil
+}
+
+func getCodeArtifactRepositoryTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getCodeArtifactRepositoryTags")
+
+	var arn *string
+	switch item := h.Item.(type) {
+	case *codeartifact.RepositorySummary:
+		arn = item.Arn
+	case *codeartifact.RepositoryDescription:
+		arn = item.Arn
+	}
+
+	return listCodeArtifactResourceTags(ctx, d, arn)
+}
+
+func listCodeArtifactResourceTags(ctx context.Context, d *plugin.QueryData, arn *string) (interface{}, error) {
+	// Create session
+	svc, err := CodeArtifactService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &codeartifact.ListTagsForResourceInput{
+		ResourceArn: arn,
+	}
+
+	op, err := svc.ListTagsForResource(params)
+	if err != nil {
+		plugin.Logger(ctx).Error("listCodeArtifactResourceTags", "ListTagsForResource_error", err)
+		return nil, err
+	}
+
+	return op.Tags, nil
+}
+
+func codeArtifactRepositoryKeys(item interface{}) (domainName, domainOwner, name *string) {
+	switch item := item.(type) {
+	case *codeartifact.RepositorySummary:
+		return item.DomainName, item.DomainOwner, item.Name
+	case *codeartifact.RepositoryDescription:
+		return item.DomainName, item.DomainOwner, item.Name
+	}
+	return nil, nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func codeArtifactTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.Value.([]*codeartifact.Tag)
+	if !ok || len(tags) == 0 {
+		return nil, nil
+	}
+
+	turbotTagsMap := map[string]string{}
+	for _, i := range tags {
+		turbotTagsMap[*i.Key] = *i.Value
+	}
+
+	return turbotTagsMap, nil
+}
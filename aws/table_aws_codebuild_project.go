@@ -36,152 +36,139 @@ func tableAwsCodeBuildProject(_ context.Context) *plugin.Table {
 			{
 				Name:        "arn",
 				Description: "The Amazon Resource Name (ARN) of the build project.",
-				Hydrate:     getCodeBuildProject,
 				Type:        proto.ColumnType_STRING,
 			},
 			{
 				Name:        "description",
 				Description: "A description that makes the build project easy to identify.",
-				Hydrate:     getCodeBuildProject,
 				Type:        proto.ColumnType_STRING,
 			},
 			{
 				Name:        "concurrent_build_limit",
 				Description: "The maximum number of concurrent builds that are allowed for this project.",
-				Hydrate:     getCodeBuildProject,
 				Type:        proto.ColumnType_INT,
 			},
 			{
 				Name:        "created",
 				Description: "When the build project was created, expressed in Unix time format.",
-				Hydrate:     getCodeBuildProject,
 				Type:        proto.ColumnType_TIMESTAMP,
 			},
 			{
 				Name:        "last_modified",
 				Description: "When the build project's settings were last modified, expressed in Unix time format.",
-				Hydrate:     getCodeBuildProject,
 				Type:        proto.ColumnType_TIMESTAMP,
 			},
 			{
 				Name:        "encryption_key",
 				Description: "The AWS Key Management Service (AWS KMS) customer master key (CMK) to be.",
-				Hydrate:     getCodeBuildProject,
 				Type:        proto.ColumnType_STRING,
 			},
 			{
 				Name:        "queued_timeout_in_minutes",
 				Description: "The number of minutes a build is allowed to be queued before it times out.",
-				Hydrate:     getCodeBuildProject,
 				Type:        proto.ColumnType_INT,
 			},
 			{
 				Name:        "service_role",
 				Description: "The ARN of the AWS Identity and Access Management (IAM) role that enables AWS CodeBuild to interact with dependent AWS services on behalf of the AWS account.",
-				Hydrate:     getCodeBuildProject,
 				Type:        proto.ColumnType_STRING,
 			},
 			{
 				Name:        "source_version",
 				Description: "A version of the build input to be built for this project.",
-				Hydrate:     getCodeBuildProject,
 				Type:        proto.ColumnType_STRING,
 			},
 			{
 				Name:        "timeout_in_minutes",
 				Description: "How long, in minutes, from 5 to 480 (8 hours), for AWS CodeBuild to wait before timing out any related build that did not get marked as completed.",
-				Hydrate:     getCodeBuildProject,
 				Type:        proto.ColumnType_INT,
 			},
 			{
 				Name:        "artifacts",
 				Description: "Information about the build output artifacts for the build project.",
-				Hydrate:     getCodeBuildProject,
 				Type:        proto.ColumnType_JSON,
 			},
 			{
 				Name:        "badge",
 				Description: "Information about the build badge for the build project.",
-				Hydrate:     getCodeBuildProject,
 				Type:        proto.ColumnType_JSON,
 			},
 			{
 				Name:        "build_batch_config",
 				Description: "A ProjectBuildBatchConfig object that defines the batch build options for the project.",
-				Hydrate:     getCodeBuildProject,
 				Type:        proto.ColumnType_JSON,
 			},
 			{
 				Name:        "cache",
 				Description: "Information about the cache for the build project.",
-				Hydrate:     getCodeBuildProject,
 				Type:        proto.ColumnType_JSON,
 			},
 			{
 				Name:        "environment",
 				Description: "Information about the build environment for this build project.",
-				Hydrate:     getCodeBuildProject,
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "privileged_mode",
+				Description: "Enables running the Docker daemon inside a Docker container. Set to true only if the build project is used to build Docker images.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Environment.PrivilegedMode"),
+			},
+			{
+				Name:        "plaintext_environment_variable_names",
+				Description: "The names of the environment variables of the build environment whose values are stored in plain text, rather than in Parameter Store or Secrets Manager.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(codeBuildProjectPlaintextEnvironmentVariableNames),
+			},
 			{
 				Name:        "file_system_locations",
 				Description: "An array of ProjectFileSystemLocation objects for a CodeBuild build project.",
-				Hydrate:     getCodeBuildProject,
 				Type:        proto.ColumnType_JSON,
 			},
 			{
 				Name:        "logs_config",
 				Description: "Information about logs for the build project. A project can create logs in Amazon CloudWatch Logs, an S3 bucket or both.",
-				Hydrate:     getCodeBuildProject,
 				Type:        proto.ColumnType_JSON,
 			},
 			{
 				Name:        "project_visibility",
 				Description: "Visibility of the build project.",
-				Hydrate:     getCodeBuildProject,
 				Type:        proto.ColumnType_STRING,
 			},
 			{
 				Name:        "secondary_artifacts",
 				Description: "An array of ProjectArtifacts objects.",
-				Hydrate:     getCodeBuildProject,
 				Type:        proto.ColumnType_JSON,
 			},
 			{
 				Name:        "secondary_source_versions",
 				Description: "An array of ProjectSource objects.",
-				Hydrate:     getCodeBuildProject,
 				Type:        proto.ColumnType_JSON,
 			},
 			{
 				Name:        "secondary_sources",
 				Description: "An array of ProjectSource objects.",
-				Hydrate:     getCodeBuildProject,
 				Type:        proto.ColumnType_JSON,
 			},
 			{
 				Name:        "source",
 				Description: "Information about the build input source code for this build project.",
-				Hydrate:     getCodeBuildProject,
 				Type:        proto.ColumnType_JSON,
 			},
 			{
 				Name:        "vpc_config",
 				Description: "Information about the VPC configuration that AWS CodeBuild accesses.",
-				Hydrate:     getCodeBuildProject,
 				Type:        proto.ColumnType_JSON,
 			},
 			{
 				Name:        "webhook",
 				Description: " Information about a webhook that connects repository events to a build project in AWS CodeBuild.",
-				Hydrate:     getCodeBuildProject,
 				Type:        proto.ColumnType_JSON,
 			},
 			{
 				Name:        "tags_src",
 				Description: "A list of tag key and value pairs associated with this build project.",
 				Type:        proto.ColumnType_JSON,
-				Hydrate:     getCodeBuildProject,
 				Transform:   transform.FromField("Tags"),
 			},
 
@@ -196,14 +183,12 @@ func tableAwsCodeBuildProject(_ context.Context) *plugin.Table {
 				Name:        "tags",
 				Description: resourceInterfaceDescription("tags"),
 				Type:        proto.ColumnType_JSON,
-				Hydrate:     getCodeBuildProject,
 				Transform:   transform.From(codeBuildProjectTurbotTags),
 			},
 			{
 				Name:        "akas",
 				Description: resourceInterfaceDescription("akas"),
 				Type:        proto.ColumnType_JSON,
-				Hydrate:     getCodeBuildProject,
 				Transform:   transform.FromField("Arn").Transform(arnToAkas),
 			},
 		}),
@@ -220,13 +205,26 @@ func listCodeBuildProjects(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 	}
 
 	// List call
+	// A page holds at most 100 project names, which is also the maximum number
+	// of projects BatchGetProjects accepts, so each page is described in one call
+	var batchErr error
 	err = svc.ListProjectsPages(
 		&codebuild.ListProjectsInput{},
 		func(page *codebuild.ListProjectsOutput, isLast bool) bool {
-			for _, result := range page.Projects {
-				d.StreamListItem(ctx, &codebuild.Project{
-					Name: result,
-				})
+			if len(page.Projects) == 0 {
+				return !isLast
+			}
+
+			op, err := svc.BatchGetProjects(&codebuild.BatchGetProjectsInput{
+				Names: page.Projects,
+			})
+			if err != nil {
+				batchErr = err
+				return false
+			}
+
+			for _, project := range op.Projects {
+				d.StreamListItem(ctx, project)
 
 				// Context can be cancelled due to manual cancellation or the limit has been hit
 				if d.QueryStatus.RowsRemaining(ctx) == 0 {
@@ -236,8 +234,11 @@ func listCodeBuildProjects(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 			return !isLast
 		},
 	)
+	if err != nil {
+		return nil, err
+	}
 
-	return nil, err
+	return nil, batchErr
 }
 
 //// HYDRATE FUNCTIONS
@@ -245,13 +246,7 @@ func listCodeBuildProjects(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 func getCodeBuildProject(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	plugin.Logger(ctx).Trace("getCodeBuildProject")
 
-	var name string
-	if h.Item != nil {
-		name = *h.Item.(*codebuild.Project).Name
-	} else {
-		quals := d.KeyColumnQuals
-		name = quals["name"].GetStringValue()
-	}
+	name := d.KeyColumnQuals["name"].GetStringValue()
 
 	// get service
 	svc, err := CodeBuildService(ctx, d)
@@ -298,3 +293,20 @@ func codeBuildProjectTurbotTags(_ context.Context, d *transform.TransformData) (
 	}
 	return turbotTagsMap, nil
 }
+
+func codeBuildProjectPlaintextEnvironmentVariableNames(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*codebuild.Project)
+
+	if data.Environment == nil {
+		return nil, nil
+	}
+
+	names := []string{}
+	for _, variable := range data.Environment.EnvironmentVariables {
+		if variable.Type == nil || *variable.Type == codebuild.EnvironmentVariableTypePlaintext {
+			names = append(names, *variable.Name)
+		}
+	}
+
+	return names, nil
+}
@@ -3,6 +3,7 @@ package aws
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codecommit"
 	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
@@ -15,6 +16,13 @@ func tableAwsCodeCommitRepository(_ context.Context) *plugin.Table {
 	return &plugin.Table{
 		Name:        "aws_codecommit_repository",
 		Description: "AWS CodeCommit Repository",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("repository_name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"RepositoryDoesNotExistException", "InvalidRepositoryNameException"}),
+			},
+			Hydrate: getCodeCommitRepository,
+		},
 		List: &plugin.ListConfig{
 			IgnoreConfig: &plugin.IgnoreConfig{
 				ShouldIgnoreErrorFunc: isNotFoundError([]string{"InvalidParameter"}),
@@ -158,6 +166,36 @@ func listCodeCommitRepositories(ctx context.Context, d *plugin.QueryData, _ *plu
 
 //// HYDRATE FUNCTIONS
 
+func getCodeCommitRepository(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getCodeCommitRepository")
+
+	name := d.KeyColumnQuals["repository_name"].GetStringValue()
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := CodeCommitService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the params
+	params := &codecommit.GetRepositoryInput{
+		RepositoryName: aws.String(name),
+	}
+
+	op, err := svc.GetRepository(params)
+	if err != nil {
+		plugin.Logger(ctx).Debug("getCodeCommitRepository", "ERROR", err)
+		return nil, err
+	}
+
+	return op.RepositoryMetadata, nil
+}
+
 func listCodeCommitRepositoryTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	plugin.Logger(ctx).Trace("listCodeCommitRepositoryTags")
 
@@ -0,0 +1,301 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCodeDeployApp(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_codedeploy_app",
+		Description: "AWS CodeDeploy Application",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("application_name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ApplicationDoesNotExistException"}),
+			},
+			Hydrate: getCodeDeployApp,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCodeDeployApps,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "application_name",
+				Description: "The application name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "application_id",
+				Description: "The application ID.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the application.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCodeDeployAppArn,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "compute_platform",
+				Description: "The destination platform type for deployment of the application, either Server, Lambda or ECS.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The time at which the application was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "github_account_name",
+				Description: "The name for a connection to a GitHub account.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GitHubAccountName"),
+			},
+			{
+				Name:        "linked_to_github",
+				Description: "True if the user has authenticated with GitHub for the specified application. Otherwise, false.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("LinkedToGitHub"),
+			},
+			{
+				Name:        "deployment_groups",
+				Description: "The deployment groups of the application, including their deployment configuration, service role, targets and last deployments.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listCodeDeployAppDeploymentGroups,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tag key and value pairs associated with this application.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCodeDeployAppTags,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ApplicationName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCodeDeployAppTags,
+				Transform:   transform.FromValue().Transform(codeDeployTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCodeDeployAppArn,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCodeDeployApps(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CodeDeployService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// List call
+	// A page holds at most 100 application names, which is also the maximum
+	// number of applications BatchGetApplications accepts
+	var batchErr error
+	err = svc.ListApplicationsPages(
+		&codedeploy.ListApplicationsInput{},
+		func(page *codedeploy.ListApplicationsOutput, isLast bool) bool {
+			if len(page.Applications) == 0 {
+				return !isLast
+			}
+
+			op, err := svc.BatchGetApplications(&codedeploy.BatchGetApplicationsInput{
+				ApplicationNames: page.Applications,
+			})
+			if err != nil {
+				batchErr = err
+				return false
+			}
+
+			for _, application := range op.ApplicationsInfo {
+				d.StreamListItem(ctx, application)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("listCodeDeployApps", "ListApplicationsPages_error", err)
+		return nil, err
+	}
+
+	return nil, batchErr
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCodeDeployApp(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getCodeDeployApp")
+
+	name := d.KeyColumnQuals["application_name"].GetStringValue()
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := CodeDeployService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the params
+	params := &codedeploy.GetApplicationInput{
+		ApplicationName: aws.String(name),
+	}
+
+	op, err := svc.GetApplication(params)
+	if err != nil {
+		plugin.Logger(ctx).Debug("getCodeDeployApp", "ERROR", err)
+		return nil, err
+	}
+
+	return op.Application, nil
+}
+
+func listCodeDeployAppDeploymentGroups(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listCodeDeployAppDeploymentGroups")
+
+	application := h.Item.(*codedeploy.ApplicationInfo)
+
+	// Create session
+	svc, err := CodeDeployService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	deploymentGroups := []*codedeploy.DeploymentGroupInfo{}
+	var batchErr error
+	err = svc.ListDeploymentGroupsPages(
+		&codedeploy.ListDeploymentGroupsInput{
+			ApplicationName: application.ApplicationName,
+		},
+		func(page *codedeploy.ListDeploymentGroupsOutput, isLast bool) bool {
+			if len(page.DeploymentGroups) == 0 {
+				return !isLast
+			}
+
+			op, err := svc.BatchGetDeploymentGroups(&codedeploy.BatchGetDeploymentGroupsInput{
+				ApplicationName:      application.ApplicationName,
+				DeploymentGroupNames: page.DeploymentGroups,
+			})
+			if err != nil {
+				batchErr = err
+				return false
+			}
+			deploymentGroups = append(deploymentGroups, op.DeploymentGroupsInfo...)
+			return !isLast
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("listCodeDeployAppDeploymentGroups", "ListDeploymentGroupsPages_error", err)
+		return nil, err
+	}
+	if batchErr != nil {
+		plugin.Logger(ctx).Error("listCodeDeployAppDeploymentGroups", "BatchGetDeploymentGroups_error", batchErr)
+		return nil, batchErr
+	}
+
+	return deploymentGroups, nil
+}
+
+func getCodeDeployAppTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getCodeDeployAppTags")
+
+	arn, err := getCodeDeployAppArn(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create session
+	svc, err := CodeDeployService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &codedeploy.ListTagsForResourceInput{
+		ResourceArn: aws.String(arn.(string)),
+	}
+
+	var tags []*codedeploy.Tag
+	for {
+		op, err := svc.ListTagsForResource(params)
+		if err != nil {
+			plugin.Logger(ctx).Error("getCodeDeployAppTags", "ListTagsForResource_error", err)
+			return nil, err
+		}
+		tags = append(tags, op.Tags...)
+		if op.NextToken == nil {
+			break
+		}
+		params.NextToken = op.NextToken
+	}
+
+	return tags, nil
+}
+
+func getCodeDeployAppArn(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getCodeDeployAppArn")
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	application := h.Item.(*codedeploy.ApplicationInfo)
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	commonColumnData := commonData.(*awsCommonColumnData)
+	arn := "arn:" + commonColumnData.Partition + ":codedeploy:" + region + ":" + commonColumnData.AccountId + ":application:" + *application.ApplicationName
+
+	return arn, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func codeDeployTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.Value.([]*codedeploy.Tag)
+	if !ok || len(tags) == 0 {
+		return nil, nil
+	}
+
+	turbotTagsMap := map[string]string{}
+	for _, i := range tags {
+		turbotTagsMap[*i.Key] = *i.Value
+	}
+
+	return turbotTagsMap, nil
+}
@@ -87,6 +87,13 @@ func tableAwsCodepipelinePipeline(_ context.Context) *plugin.Table {
 				Hydrate:     getCodepipelinePipeline,
 				Transform:   transform.FromField("Pipeline.Stages"),
 			},
+			{
+				Name:        "stage_states",
+				Description: "The current state of each stage of the pipeline, including the status of its actions and its latest execution.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCodepipelinePipelineState,
+				Transform:   transform.FromField("StageStates"),
+			},
 			{
 				Name:        "tags_src",
 				Description: "A list of tag key and value pairs associated with this pipeline.",
@@ -205,6 +212,37 @@ func getCodepipelinePipeline(ctx context.Context, d *plugin.QueryData, h *plugin
 	return nil, nil
 }
 
+func getCodepipelinePipelineState(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getCodepipelinePipelineState")
+
+	var name *string
+	switch item := h.Item.(type) {
+	case *codepipeline.PipelineSummary:
+		name = item.Name
+	case *codepipeline.GetPipelineOutput:
+		name = item.Pipeline.Name
+	}
+
+	// Create session
+	svc, err := CodePipelineService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build params
+	params := &codepipeline.GetPipelineStateInput{
+		Name: name,
+	}
+
+	op, err := svc.GetPipelineState(params)
+	if err != nil {
+		plugin.Logger(ctx).Error("getCodepipelinePipelineState", "GetPipelineState_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
 func getPipelineTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	plugin.Logger(ctx).Trace("getPipelineTags")
 
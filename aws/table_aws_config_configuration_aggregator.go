@@ -0,0 +1,226 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsConfigConfigurationAggregator(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_config_configuration_aggregator",
+		Description: "AWS Config Configuration Aggregator",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"NoSuchConfigurationAggregatorException", "InvalidParameterValueException"}),
+			},
+			Hydrate: getConfigConfigurationAggregator,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listConfigConfigurationAggregators,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the aggregator.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConfigurationAggregatorName"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the aggregator.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConfigurationAggregatorArn"),
+			},
+			{
+				Name:        "created_by",
+				Description: "The Amazon Web Services service that created the configuration aggregator.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The time stamp when the configuration aggregator was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_updated_time",
+				Description: "The time of the last update.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "account_aggregation_sources",
+				Description: "Provides a list of source accounts and regions to be aggregated.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "organization_aggregation_source",
+				Description: "Provides an organization and list of regions to be aggregated.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "source_status",
+				Description: "The current sync status of each source account or organization and region in the aggregator.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listConfigConfigurationAggregatorSourcesStatus,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags attached to the aggregator.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getConfigConfigurationAggregatorTags,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getConfigConfigurationAggregatorTags,
+				Transform:   transform.FromField("Tags").Transform(configAggregateAuthorizationsTagListToTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConfigurationAggregatorName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ConfigurationAggregatorArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listConfigConfigurationAggregators(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := ConfigService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_config_configuration_aggregator.listConfigConfigurationAggregators", "service_connection_error", err)
+		return nil, err
+	}
+
+	input := &configservice.DescribeConfigurationAggregatorsInput{}
+
+	err = svc.DescribeConfigurationAggregatorsPages(
+		input,
+		func(page *configservice.DescribeConfigurationAggregatorsOutput, lastPage bool) bool {
+			for _, aggregator := range page.ConfigurationAggregators {
+				d.StreamListItem(ctx, aggregator)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_config_configuration_aggregator.listConfigConfigurationAggregators", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getConfigConfigurationAggregator(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := ConfigService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_config_configuration_aggregator.getConfigConfigurationAggregator", "service_connection_error", err)
+		return nil, err
+	}
+
+	params := &configservice.DescribeConfigurationAggregatorsInput{
+		ConfigurationAggregatorNames: []*string{aws.String(name)},
+	}
+
+	op, err := svc.DescribeConfigurationAggregators(params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_config_configuration_aggregator.getConfigConfigurationAggregator", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.ConfigurationAggregators) > 0 {
+		return op.ConfigurationAggregators[0], nil
+	}
+	return nil, nil
+}
+
+func listConfigConfigurationAggregatorSourcesStatus(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	aggregator := h.Item.(*configservice.ConfigurationAggregator)
+
+	// Create Session
+	svc, err := ConfigService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_config_configuration_aggregator.listConfigConfigurationAggregatorSourcesStatus", "service_connection_error", err)
+		return nil, err
+	}
+
+	params := &configservice.DescribeConfigurationAggregatorSourcesStatusInput{
+		ConfigurationAggregatorName: aggregator.ConfigurationAggregatorName,
+	}
+
+	var statuses []*configservice.AggregatedSourceStatus
+	err = svc.DescribeConfigurationAggregatorSourcesStatusPages(
+		params,
+		func(page *configservice.DescribeConfigurationAggregatorSourcesStatusOutput, lastPage bool) bool {
+			statuses = append(statuses, page.AggregatedSourceStatusList...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_config_configuration_aggregator.listConfigConfigurationAggregatorSourcesStatus", "api_error", err)
+		return nil, err
+	}
+
+	return statuses, nil
+}
+
+func getConfigConfigurationAggregatorTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	aggregator := h.Item.(*configservice.ConfigurationAggregator)
+
+	// Create Session
+	svc, err := ConfigService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_config_configuration_aggregator.getConfigConfigurationAggregatorTags", "service_connection_error", err)
+		return nil, err
+	}
+
+	// Build the params
+	params := &configservice.ListTagsForResourceInput{
+		ResourceArn: aggregator.ConfigurationAggregatorArn,
+	}
+
+	// Get call
+	op, err := svc.ListTagsForResource(params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_config_configuration_aggregator.getConfigConfigurationAggregatorTags", "api_error", err)
+		return nil, err
+	}
+	return op, nil
+}
@@ -51,6 +51,18 @@ func tableAwsConfigConfigurationRecorder(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("RecordingGroup"),
 			},
+			{
+				Name:        "all_supported",
+				Description: "Specifies whether AWS Config records configuration changes for every supported type of regional resource.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("RecordingGroup.AllSupported"),
+			},
+			{
+				Name:        "include_global_resource_types",
+				Description: "Specifies whether AWS Config includes all supported types of global resources (for example, IAM resources) with the resources that it records.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("RecordingGroup.IncludeGlobalResourceTypes"),
+			},
 			{
 				Name:        "role_arn",
 				Description: "Amazon Resource Name (ARN) of the IAM role used to describe the AWS resources associated with the account.",
@@ -64,6 +76,13 @@ func tableAwsConfigConfigurationRecorder(_ context.Context) *plugin.Table {
 				Hydrate:     getConfigConfigurationRecorderStatus,
 				Transform:   transform.FromField("Recording"),
 			},
+			{
+				Name:        "status_last_status",
+				Description: "The last (previous) status of the recorder, e.g. Pending, Success or Failure.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getConfigConfigurationRecorderStatus,
+				Transform:   transform.FromField("LastStatus"),
+			},
 			{
 				Name:        "status",
 				Description: "The current status of the configuration recorder.",
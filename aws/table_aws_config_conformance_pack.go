@@ -69,6 +69,13 @@ func tableAwsConfigConformancePack(_ context.Context) *plugin.Table {
 				Description: "Last update to the conformance pack.",
 				Type:        proto.ColumnType_TIMESTAMP,
 			},
+			{
+				Name:        "compliance_status",
+				Description: "The compliance status of the conformance pack, either COMPLIANT, NON_COMPLIANT or INSUFFICIENT_DATA.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getConfigConformancePackComplianceSummary,
+				Transform:   transform.FromField("ConformancePackComplianceStatus"),
+			},
 			{
 				Name:        "input_parameters",
 				Description: "A list of ConformancePackInputParameter objects.",
@@ -165,10 +172,37 @@ func getConfigConformancePack(ctx context.Context, d *plugin.QueryData, _ *plugi
 		return nil, err
 	}
 
-	if op != nil {
+	if op != nil && len(op.ConformancePackDetails) > 0 {
 		logger.Debug("getConfigConformancePack", "SUCCESS", op)
 		return op.ConformancePackDetails[0], nil
 	}
 
 	return nil, nil
 }
+
+func getConfigConformancePackComplianceSummary(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getConfigConformancePackComplianceSummary")
+
+	name := h.Item.(*configservice.ConformancePackDetail).ConformancePackName
+
+	// Create Session
+	svc, err := ConfigService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &configservice.GetConformancePackComplianceSummaryInput{
+		ConformancePackNames: []*string{name},
+	}
+
+	op, err := svc.GetConformancePackComplianceSummary(params)
+	if err != nil {
+		plugin.Logger(ctx).Debug("getConfigConformancePackComplianceSummary", "ERROR", err)
+		return nil, err
+	}
+
+	if len(op.ConformancePackComplianceSummaryList) > 0 {
+		return op.ConformancePackComplianceSummaryList[0], nil
+	}
+	return nil, nil
+}
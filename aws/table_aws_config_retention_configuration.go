@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsConfigRetentionConfiguration(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_config_retention_configuration",
+		Description: "AWS Config Retention Configuration",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"NoSuchRetentionConfigurationException", "InvalidParameterValueException"}),
+			},
+			Hydrate: getConfigRetentionConfiguration,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listConfigRetentionConfigurations,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the retention configuration object.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "retention_period_in_days",
+				Description: "Number of days Config stores your historical information.",
+				Type:        proto.ColumnType_INT,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listConfigRetentionConfigurations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := ConfigService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_config_retention_configuration.listConfigRetentionConfigurations", "service_connection_error", err)
+		return nil, err
+	}
+
+	input := &configservice.DescribeRetentionConfigurationsInput{}
+
+	err = svc.DescribeRetentionConfigurationsPages(
+		input,
+		func(page *configservice.DescribeRetentionConfigurationsOutput, lastPage bool) bool {
+			for _, retentionConfiguration := range page.RetentionConfigurations {
+				d.StreamListItem(ctx, retentionConfiguration)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_config_retention_configuration.listConfigRetentionConfigurations", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getConfigRetentionConfiguration(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := ConfigService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_config_retention_configuration.getConfigRetentionConfiguration", "service_connection_error", err)
+		return nil, err
+	}
+
+	params := &configservice.DescribeRetentionConfigurationsInput{
+		RetentionConfigurationNames: []*string{aws.String(name)},
+	}
+
+	op, err := svc.DescribeRetentionConfigurations(params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_config_retention_configuration.getConfigRetentionConfiguration", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.RetentionConfigurations) > 0 {
+		return op.RetentionConfigurations[0], nil
+	}
+	return nil, nil
+}
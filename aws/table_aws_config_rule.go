@@ -80,6 +80,13 @@ func tableAwsConfigRule(_ context.Context) *plugin.Table {
 				Hydrate:     getComplianceByConfigRules,
 				Transform:   transform.FromValue(),
 			},
+			{
+				Name:        "evaluation_status",
+				Description: "The status of the rule's most recent evaluations, including the last successful and failed invocation and evaluation times.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getConfigRuleEvaluationStatus,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "input_parameters",
 				Description: "A string, in JSON format, that is passed to the AWS Config rule Lambda function.",
@@ -239,6 +246,34 @@ func getComplianceByConfigRules(ctx context.Context, d *plugin.QueryData, h *plu
 	return op.ComplianceByConfigRules, nil
 }
 
+func getConfigRuleEvaluationStatus(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getConfigRuleEvaluationStatus")
+
+	// Create Session
+	svc, err := ConfigService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("getConfigRuleEvaluationStatus", "connection", err)
+		return nil, err
+	}
+	ruleName := h.Item.(*configservice.ConfigRule).ConfigRuleName
+
+	// Build params
+	params := &configservice.DescribeConfigRuleEvaluationStatusInput{
+		ConfigRuleNames: []*string{ruleName},
+	}
+
+	op, err := svc.DescribeConfigRuleEvaluationStatus(params)
+	if err != nil {
+		plugin.Logger(ctx).Error("getConfigRuleEvaluationStatus", "DescribeConfigRuleEvaluationStatus", err)
+		return nil, err
+	}
+
+	if len(op.ConfigRulesEvaluationStatus) > 0 {
+		return op.ConfigRulesEvaluationStatus[0], nil
+	}
+	return nil, nil
+}
+
 //// TRANSFORM FUNCTIONS
 
 func configRuleTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
@@ -13,7 +13,8 @@ func tableAwsCostByLinkedAccountDaily(_ context.Context) *plugin.Table {
 		Name:        "aws_cost_by_account_daily",
 		Description: "AWS Cost Explorer - Cost by Linked Account (Daily)",
 		List: &plugin.ListConfig{
-			Hydrate: listCostByLinkedAccountDaily,
+			Hydrate:    listCostByLinkedAccountDaily,
+			KeyColumns: costExplorerPeriodKeyColumns(),
 		},
 		Columns: awsColumns(
 			costExplorerColumns([]*plugin.Column{
@@ -32,6 +33,6 @@ func tableAwsCostByLinkedAccountDaily(_ context.Context) *plugin.Table {
 //// LIST FUNCTION
 
 func listCostByLinkedAccountDaily(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	params := buildCostByLinkedAccountInput("DAILY")
+	params := buildCostByLinkedAccountInput("DAILY", d)
 	return streamCostAndUsage(ctx, d, params)
 }
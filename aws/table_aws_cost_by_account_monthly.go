@@ -2,7 +2,6 @@ package aws
 
 import (
 	"context"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/costexplorer"
@@ -17,7 +16,8 @@ func tableAwsCostByLinkedAccountMonthly(_ context.Context) *plugin.Table {
 		Name:        "aws_cost_by_account_monthly",
 		Description: "AWS Cost Explorer - Cost by Linked Account (Monthly)",
 		List: &plugin.ListConfig{
-			Hydrate: listCostByLinkedAccountMonthly,
+			Hydrate:    listCostByLinkedAccountMonthly,
+			KeyColumns: costExplorerPeriodKeyColumns(),
 		},
 		Columns: awsColumns(
 			costExplorerColumns([]*plugin.Column{
@@ -36,24 +36,14 @@ func tableAwsCostByLinkedAccountMonthly(_ context.Context) *plugin.Table {
 //// LIST FUNCTION
 
 func listCostByLinkedAccountMonthly(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	params := buildCostByLinkedAccountInput("MONTHLY")
+	params := buildCostByLinkedAccountInput("MONTHLY", d)
 
 	return streamCostAndUsage(ctx, d, params)
 }
 
-func buildCostByLinkedAccountInput(granularity string) *costexplorer.GetCostAndUsageInput {
-	timeFormat := "2006-01-02"
-	if granularity == "HOURLY" {
-		timeFormat = "2006-01-02T15:04:05Z"
-	}
-	endTime := time.Now().Format(timeFormat)
-	startTime := getCEStartDateForGranularity(granularity).Format(timeFormat)
-
+func buildCostByLinkedAccountInput(granularity string, d *plugin.QueryData) *costexplorer.GetCostAndUsageInput {
 	params := &costexplorer.GetCostAndUsageInput{
-		TimePeriod: &costexplorer.DateInterval{
-			Start: aws.String(startTime),
-			End:   aws.String(endTime),
-		},
+		TimePeriod:  getCETimePeriod(granularity, d),
 		Granularity: aws.String(granularity),
 		Metrics:     aws.StringSlice(AllCostMetrics()),
 		GroupBy: []*costexplorer.GroupDefinition{
@@ -14,9 +14,9 @@ func tableAwsCostByServiceDaily(_ context.Context) *plugin.Table {
 		Description: "AWS Cost Explorer - Cost by Service (Daily)",
 		List: &plugin.ListConfig{
 			Hydrate: listCostByServiceDaily,
-			KeyColumns: plugin.KeyColumnSlice{
+			KeyColumns: append(plugin.KeyColumnSlice{
 				{Name: "service", Operators: []string{"=", "<>"}, Require: plugin.Optional},
-			},
+			}, costExplorerPeriodKeyColumns()...),
 		},
 		Columns: awsColumns(
 			costExplorerColumns([]*plugin.Column{
@@ -3,7 +3,6 @@ package aws
 import (
 	"context"
 	"strings"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/costexplorer"
@@ -19,9 +18,9 @@ func tableAwsCostByServiceMonthly(_ context.Context) *plugin.Table {
 		Description: "AWS Cost Explorer - Cost by Service (Monthly)",
 		List: &plugin.ListConfig{
 			Hydrate: listCostByServiceMonthly,
-			KeyColumns: plugin.KeyColumnSlice{
+			KeyColumns: append(plugin.KeyColumnSlice{
 				{Name: "service", Operators: []string{"=", "<>"}, Require: plugin.Optional},
-			},
+			}, costExplorerPeriodKeyColumns()...),
 		},
 		Columns: awsColumns(
 			costExplorerColumns([]*plugin.Column{
@@ -44,18 +43,8 @@ func listCostByServiceMonthly(ctx context.Context, d *plugin.QueryData, _ *plugi
 }
 
 func buildCostByServiceInput(granularity string, d *plugin.QueryData) *costexplorer.GetCostAndUsageInput {
-	timeFormat := "2006-01-02"
-	if granularity == "HOURLY" {
-		timeFormat = "2006-01-02T15:04:05Z"
-	}
-	endTime := time.Now().Format(timeFormat)
-	startTime := getCEStartDateForGranularity(granularity).Format(timeFormat)
-
 	params := &costexplorer.GetCostAndUsageInput{
-		TimePeriod: &costexplorer.DateInterval{
-			Start: aws.String(startTime),
-			End:   aws.String(endTime),
-		},
+		TimePeriod:  getCETimePeriod(granularity, d),
 		Granularity: aws.String(granularity),
 		Metrics:     aws.StringSlice(AllCostMetrics()),
 		GroupBy: []*costexplorer.GroupDefinition{
@@ -69,6 +58,10 @@ func buildCostByServiceInput(granularity string, d *plugin.QueryData) *costexplo
 	var filters []*costexplorer.Expression
 
 	for _, keyQual := range d.Table.List.KeyColumns {
+		// The period quals are applied to the time period rather than filtered on
+		if keyQual.Name == "period_start" || keyQual.Name == "period_end" {
+			continue
+		}
 		filterQual := d.Quals[keyQual.Name]
 		if filterQual == nil {
 			continue
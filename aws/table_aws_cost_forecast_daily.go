@@ -37,6 +37,16 @@ func tableAwsCostForecastDaily(_ context.Context) *plugin.Table {
 				Description: "Average forecasted value",
 				Type:        proto.ColumnType_DOUBLE,
 			},
+			{
+				Name:        "prediction_interval_lower_bound",
+				Description: "The lower limit for the 80% prediction interval of the forecast",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "prediction_interval_upper_bound",
+				Description: "The upper limit for the 80% prediction interval of the forecast",
+				Type:        proto.ColumnType_DOUBLE,
+			},
 		},
 		),
 	}
@@ -57,6 +67,7 @@ func listCostForecastDaily(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 
 	params := buildCostForecastInput(d.KeyColumnQuals, "DAILY")
 
+	logger.Info("listCostForecast", "GetCostForecast request, charged at $0.01 per request", "granularity", *params.Granularity)
 	output, err := svc.GetCostForecast(params)
 	if err != nil {
 		logger.Error("listCostForecast", "err", err)
@@ -97,6 +108,9 @@ func buildCostForecastInput(_ map[string]*proto.QualValue, granularity string) *
 		},
 		Granularity: aws.String(granularity),
 		Metric:      aws.String(metric),
+
+		// The prediction interval bounds are only returned when a level is requested
+		PredictionIntervalLevel: aws.Int64(80),
 	}
 
 	return params
@@ -33,6 +33,16 @@ func tableAwsCostForecastMonthly(_ context.Context) *plugin.Table {
 				Description: "Average forecasted value",
 				Type:        proto.ColumnType_DOUBLE,
 			},
+			{
+				Name:        "prediction_interval_lower_bound",
+				Description: "The lower limit for the 80% prediction interval of the forecast",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "prediction_interval_upper_bound",
+				Description: "The upper limit for the 80% prediction interval of the forecast",
+				Type:        proto.ColumnType_DOUBLE,
+			},
 		},
 		),
 	}
@@ -53,6 +63,7 @@ func listCostForecastMonthly(ctx context.Context, d *plugin.QueryData, _ *plugin
 
 	params := buildCostForecastInput(d.KeyColumnQuals, "MONTHLY")
 
+	logger.Info("listCostForecast", "GetCostForecast request, charged at $0.01 per request", "granularity", *params.Granularity)
 	output, err := svc.GetCostForecast(params)
 	if err != nil {
 		logger.Error("listCostForecast", "err", err)
@@ -2,16 +2,25 @@ package aws
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/turbot/go-kit/helpers"
 
 	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
 )
 
+// costUsageDimensions are the dimensions the results can be grouped by
+var costUsageDimensions = []string{
+	"AZ", "INSTANCE_TYPE", "LINKED_ACCOUNT", "OPERATION", "PURCHASE_TYPE", "SERVICE", "USAGE_TYPE", "PLATFORM", "TENANCY",
+	"RECORD_TYPE", "LEGAL_ENTITY_NAME", "DEPLOYMENT_OPTION", "DATABASE_ENGINE", "CACHE_ENGINE", "INSTANCE_TYPE_FAMILY",
+	"REGION", "BILLING_ENTITY", "RESERVATION_ID", "SAVINGS_PLANS_TYPE", "SAVINGS_PLAN_ARN", "OPERATING_SYSTEM",
+}
+
 func tableAwsCostAndUsage(_ context.Context) *plugin.Table {
 	return &plugin.Table{
 		Name:        "aws_cost_usage",
@@ -37,31 +46,31 @@ func tableAwsCostAndUsage(_ context.Context) *plugin.Table {
 				// Quals columns - to filter the lookups
 				{
 					Name:        "granularity",
-					Description: "",
+					Description: "The granularity of the results, either DAILY, MONTHLY or HOURLY.",
 					Type:        proto.ColumnType_STRING,
 					Hydrate:     hydrateCostAndUsageQuals,
 				},
 				{
 					Name:        "search_start_time",
-					Description: "",
+					Description: "The start of the time period searched.",
 					Type:        proto.ColumnType_TIMESTAMP,
 					Hydrate:     hydrateCostAndUsageQuals,
 				},
 				{
 					Name:        "search_end_time",
-					Description: "",
+					Description: "The end of the time period searched.",
 					Type:        proto.ColumnType_TIMESTAMP,
 					Hydrate:     hydrateCostAndUsageQuals,
 				},
 				{
 					Name:        "dimension_type_1",
-					Description: "",
+					Description: "The dimension the results are grouped by first, e.g. SERVICE. dimension_1 contains its values.",
 					Type:        proto.ColumnType_STRING,
 					Hydrate:     hydrateCostAndUsageQuals,
 				},
 				{
 					Name:        "dimension_type_2",
-					Description: "",
+					Description: "The dimension the results are grouped by second, e.g. USAGE_TYPE. dimension_2 contains its values.",
 					Type:        proto.ColumnType_STRING,
 					Hydrate:     hydrateCostAndUsageQuals,
 				},
@@ -107,12 +116,18 @@ func tableAwsCostAndUsage(_ context.Context) *plugin.Table {
 //// LIST FUNCTION
 
 func listCostAndUsage(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	params := buildInputFromQuals(d.KeyColumnQuals)
+	params, err := buildInputFromQuals(d.KeyColumnQuals)
+	if err != nil {
+		return nil, err
+	}
 	return streamCostAndUsage(ctx, d, params)
 }
 
-func buildInputFromQuals(keyQuals map[string]*proto.QualValue) *costexplorer.GetCostAndUsageInput {
+func buildInputFromQuals(keyQuals map[string]*proto.QualValue) (*costexplorer.GetCostAndUsageInput, error) {
 	granularity := strings.ToUpper(keyQuals["granularity"].GetStringValue())
+	if !helpers.StringSliceContains(costexplorer.Granularity_Values(), granularity) {
+		return nil, fmt.Errorf("invalid granularity %q, valid values are: %s", granularity, strings.Join(costexplorer.Granularity_Values(), ", "))
+	}
 	timeFormat := "2006-01-02"
 	if granularity == "HOURLY" {
 		timeFormat = "2006-01-02T15:04:05Z"
@@ -123,6 +138,13 @@ func buildInputFromQuals(keyQuals map[string]*proto.QualValue) *costexplorer.Get
 	dim1 := strings.ToUpper(keyQuals["dimension_type_1"].GetStringValue())
 	dim2 := strings.ToUpper(keyQuals["dimension_type_2"].GetStringValue())
 
+	// Validate the dimensions here, the API error does not list the valid ones
+	for _, dim := range []string{dim1, dim2} {
+		if dim != "" && !helpers.StringSliceContains(costUsageDimensions, dim) {
+			return nil, fmt.Errorf("invalid dimension %q, valid dimensions are: %s", dim, strings.Join(costUsageDimensions, ", "))
+		}
+	}
+
 	params := &costexplorer.GetCostAndUsageInput{
 		TimePeriod: &costexplorer.DateInterval{
 			Start: aws.String(startTime),
@@ -146,7 +168,7 @@ func buildInputFromQuals(keyQuals map[string]*proto.QualValue) *costexplorer.Get
 	}
 	params.SetGroupBy(groupings)
 
-	return params
+	return params, nil
 }
 
 //// HYDRATE FUNCTIONS
@@ -0,0 +1,264 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsDirectConnectConnection(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_directconnect_connection",
+		Description: "AWS Direct Connect Connection",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("connection_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"DirectConnectClientException"}),
+			},
+			Hydrate: getDirectConnectConnection,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listDirectConnectConnections,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "connection_name",
+				Description: "The name of the connection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "connection_id",
+				Description: "The ID of the connection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the connection.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDirectConnectConnectionARN,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "connection_state",
+				Description: "The state of the connection, e.g. ordering, requested, pending, available, down, deleting, deleted, rejected or unknown.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "location",
+				Description: "The location of the connection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "bandwidth",
+				Description: "The bandwidth of the connection, e.g. 1Gbps or 10Gbps.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vlan",
+				Description: "The ID of the VLAN.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "partner_name",
+				Description: "The name of the AWS Direct Connect service provider associated with the connection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provider_name",
+				Description: "The name of the service provider associated with the connection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lag_id",
+				Description: "The ID of the link aggregation group (LAG) the connection belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "aws_device_v2",
+				Description: "The Direct Connect endpoint that terminates the physical connection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "aws_logical_device_id",
+				Description: "The Direct Connect endpoint that terminates the logical connection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "has_logical_redundancy",
+				Description: "Indicates whether the connection supports a secondary BGP peer in the same address family, either yes, no or unknown.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "jumbo_frame_capable",
+				Description: "Indicates whether jumbo frames (9001 MTU) are supported.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "mac_sec_capable",
+				Description: "Indicates whether the connection supports MAC Security (MACsec).",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "encryption_mode",
+				Description: "The MAC Security (MACsec) connection encryption mode, either no_encrypt, should_encrypt or must_encrypt.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "port_encryption_status",
+				Description: "The MAC Security (MACsec) port link status of the connection, either Encryption Up or Encryption Down.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "mac_sec_keys",
+				Description: "The MAC Security (MACsec) security keys associated with the connection, with their state.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "loa_issue_time",
+				Description: "The time of the most recent call to DescribeLoa for this connection.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "owner_account",
+				Description: "The ID of the AWS account that owns the connection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the connection.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConnectionName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(directConnectTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDirectConnectConnectionARN,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDirectConnectConnections(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	plugin.Logger(ctx).Trace("listDirectConnectConnections", "region", region)
+
+	// Create Session
+	svc, err := DirectConnectService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// List call, the connections are not paginated
+	op, err := svc.DescribeConnections(&directconnect.DescribeConnectionsInput{})
+	if err != nil {
+		plugin.Logger(ctx).Error("listDirectConnectConnections", "DescribeConnections_error", err)
+		return nil, err
+	}
+
+	for _, connection := range op.Connections {
+		d.StreamListItem(ctx, connection)
+
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDirectConnectConnection(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	connectionId := d.KeyColumnQuals["connection_id"].GetStringValue()
+
+	// Empty check
+	if connectionId == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := DirectConnectService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := svc.DescribeConnections(&directconnect.DescribeConnectionsInput{
+		ConnectionId: aws.String(connectionId),
+	})
+	if err != nil {
+		plugin.Logger(ctx).Debug("getDirectConnectConnection", "ERROR", err)
+		return nil, err
+	}
+
+	if len(op.Connections) > 0 {
+		return op.Connections[0], nil
+	}
+	return nil, nil
+}
+
+func getDirectConnectConnectionARN(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getDirectConnectConnectionARN")
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	connection := h.Item.(*directconnect.Connection)
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Get the resource arn
+	arn := "arn:" + commonColumnData.Partition + ":directconnect:" + region + ":" + commonColumnData.AccountId + ":dxcon/" + *connection.ConnectionId
+
+	return arn, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func directConnectTagListToTurbotTags(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("directConnectTagListToTurbotTags")
+	tags, ok := d.Value.([]*directconnect.Tag)
+	if !ok {
+		return nil, nil
+	}
+
+	// Mapping the resource tags inside turbotTags
+	var turbotTagsMap map[string]string
+	if tags != nil {
+		turbotTagsMap = map[string]string{}
+		for _, i := range tags {
+			turbotTagsMap[*i.Key] = aws.StringValue(i.Value)
+		}
+	}
+
+	return turbotTagsMap, nil
+}
@@ -0,0 +1,215 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsDirectConnectGateway(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_directconnect_gateway",
+		Description: "AWS Direct Connect Gateway",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("direct_connect_gateway_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"DirectConnectClientException"}),
+			},
+			Hydrate: getDirectConnectGateway,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listDirectConnectGateways,
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "direct_connect_gateway_name",
+				Description: "The name of the Direct Connect gateway.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "direct_connect_gateway_id",
+				Description: "The ID of the Direct Connect gateway.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the Direct Connect gateway.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDirectConnectGatewayARN,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "direct_connect_gateway_state",
+				Description: "The state of the Direct Connect gateway, either pending, available, deleting or deleted.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "amazon_side_asn",
+				Description: "The autonomous system number (ASN) for the Amazon side of the connection.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "owner_account",
+				Description: "The ID of the AWS account that owns the Direct Connect gateway.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state_change_error",
+				Description: "The error message if the state of an object failed to advance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "associations",
+				Description: "The virtual private gateways and transit gateways associated with the Direct Connect gateway, with their association state and allowed prefixes.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDirectConnectGatewayAssociations,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DirectConnectGatewayName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDirectConnectGatewayARN,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDirectConnectGateways(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listDirectConnectGateways")
+
+	// Direct Connect gateways are global resources, so they are listed from the default region
+	svc, err := DirectConnectService(ctx, d, GetDefaultAwsRegion(d))
+	if err != nil {
+		return nil, err
+	}
+
+	input := &directconnect.DescribeDirectConnectGatewaysInput{
+		MaxResults: aws.Int64(100),
+	}
+
+	// List call
+	for {
+		op, err := svc.DescribeDirectConnectGateways(input)
+		if err != nil {
+			plugin.Logger(ctx).Error("listDirectConnectGateways", "DescribeDirectConnectGateways_error", err)
+			return nil, err
+		}
+
+		for _, gateway := range op.DirectConnectGateways {
+			d.StreamListItem(ctx, gateway)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if op.NextToken == nil {
+			break
+		}
+		input.NextToken = op.NextToken
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDirectConnectGateway(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getDirectConnectGateway")
+
+	gatewayId := d.KeyColumnQuals["direct_connect_gateway_id"].GetStringValue()
+
+	// Empty check
+	if gatewayId == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := DirectConnectService(ctx, d, GetDefaultAwsRegion(d))
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := svc.DescribeDirectConnectGateways(&directconnect.DescribeDirectConnectGatewaysInput{
+		DirectConnectGatewayId: aws.String(gatewayId),
+	})
+	if err != nil {
+		plugin.Logger(ctx).Debug("getDirectConnectGateway", "ERROR", err)
+		return nil, err
+	}
+
+	if len(op.DirectConnectGateways) > 0 {
+		return op.DirectConnectGateways[0], nil
+	}
+	return nil, nil
+}
+
+func getDirectConnectGatewayAssociations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getDirectConnectGatewayAssociations")
+	gateway := h.Item.(*directconnect.Gateway)
+
+	// Create Session
+	svc, err := DirectConnectService(ctx, d, GetDefaultAwsRegion(d))
+	if err != nil {
+		return nil, err
+	}
+
+	input := &directconnect.DescribeDirectConnectGatewayAssociationsInput{
+		DirectConnectGatewayId: gateway.DirectConnectGatewayId,
+		MaxResults:             aws.Int64(100),
+	}
+
+	var associations []*directconnect.GatewayAssociation
+	for {
+		op, err := svc.DescribeDirectConnectGatewayAssociations(input)
+		if err != nil {
+			plugin.Logger(ctx).Error("getDirectConnectGatewayAssociations", "DescribeDirectConnectGatewayAssociations_error", err)
+			return nil, err
+		}
+		associations = append(associations, op.DirectConnectGatewayAssociations...)
+
+		if op.NextToken == nil {
+			break
+		}
+		input.NextToken = op.NextToken
+	}
+
+	return associations, nil
+}
+
+func getDirectConnectGatewayARN(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getDirectConnectGatewayARN")
+	gateway := h.Item.(*directconnect.Gateway)
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Direct Connect gateways are global, so the ARN has no region
+	arn := "arn:" + commonColumnData.Partition + ":directconnect::" + commonColumnData.AccountId + ":dx-gateway/" + *gateway.DirectConnectGatewayId
+
+	return arn, nil
+}
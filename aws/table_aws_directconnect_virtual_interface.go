@@ -0,0 +1,261 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsDirectConnectVirtualInterface(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_directconnect_virtual_interface",
+		Description: "AWS Direct Connect Virtual Interface",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("virtual_interface_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"DirectConnectClientException"}),
+			},
+			Hydrate: getDirectConnectVirtualInterface,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listDirectConnectVirtualInterfaces,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "connection_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "virtual_interface_name",
+				Description: "The name of the virtual interface.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "virtual_interface_id",
+				Description: "The ID of the virtual interface.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the virtual interface.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDirectConnectVirtualInterfaceARN,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "virtual_interface_type",
+				Description: "The type of virtual interface, either private, public or transit.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "virtual_interface_state",
+				Description: "The state of the virtual interface, e.g. confirming, verifying, pending, available, down, deleting, deleted, rejected or unknown.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "connection_id",
+				Description: "The ID of the connection the virtual interface is provisioned on.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vlan",
+				Description: "The ID of the VLAN.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "asn",
+				Description: "The autonomous system (AS) number for Border Gateway Protocol (BGP) configuration of the customer side.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "amazon_side_asn",
+				Description: "The autonomous system number (ASN) for the Amazon side of the connection.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "address_family",
+				Description: "The address family for the BGP peer, either ipv4 or ipv6.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "amazon_address",
+				Description: "The IP address assigned to the Amazon interface.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "customer_address",
+				Description: "The IP address assigned to the customer interface.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "mtu",
+				Description: "The maximum transmission unit (MTU), in bytes, either 1500 or 9001.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "jumbo_frame_capable",
+				Description: "Indicates whether jumbo frames (9001 MTU) are supported.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "site_link_enabled",
+				Description: "Indicates whether SiteLink is enabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "direct_connect_gateway_id",
+				Description: "The ID of the Direct Connect gateway the virtual interface is attached to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "virtual_gateway_id",
+				Description: "The ID of the virtual private gateway the private virtual interface is attached to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "location",
+				Description: "The location of the connection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "aws_device_v2",
+				Description: "The Direct Connect endpoint that terminates the physical connection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "owner_account",
+				Description: "The ID of the AWS account that owns the virtual interface.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "bgp_peers",
+				Description: "The BGP peers configured on the virtual interface, with their peer state and BGP status.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "route_filter_prefixes",
+				Description: "The routes to be advertised to the AWS network in this region, for public virtual interfaces.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the virtual interface.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualInterfaceName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(directConnectTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDirectConnectVirtualInterfaceARN,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDirectConnectVirtualInterfaces(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	plugin.Logger(ctx).Trace("listDirectConnectVirtualInterfaces", "region", region)
+
+	// Create Session
+	svc, err := DirectConnectService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &directconnect.DescribeVirtualInterfacesInput{}
+	if d.KeyColumnQuals["connection_id"] != nil {
+		input.ConnectionId = aws.String(d.KeyColumnQuals["connection_id"].GetStringValue())
+	}
+
+	// List call, the virtual interfaces are not paginated
+	op, err := svc.DescribeVirtualInterfaces(input)
+	if err != nil {
+		plugin.Logger(ctx).Error("listDirectConnectVirtualInterfaces", "DescribeVirtualInterfaces_error", err)
+		return nil, err
+	}
+
+	for _, virtualInterface := range op.VirtualInterfaces {
+		d.StreamListItem(ctx, virtualInterface)
+
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDirectConnectVirtualInterface(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	virtualInterfaceId := d.KeyColumnQuals["virtual_interface_id"].GetStringValue()
+
+	// Empty check
+	if virtualInterfaceId == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := DirectConnectService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := svc.DescribeVirtualInterfaces(&directconnect.DescribeVirtualInterfacesInput{
+		VirtualInterfaceId: aws.String(virtualInterfaceId),
+	})
+	if err != nil {
+		plugin.Logger(ctx).Debug("getDirectConnectVirtualInterface", "ERROR", err)
+		return nil, err
+	}
+
+	if len(op.VirtualInterfaces) > 0 {
+		return op.VirtualInterfaces[0], nil
+	}
+	return nil, nil
+}
+
+func getDirectConnectVirtualInterfaceARN(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getDirectConnectVirtualInterfaceARN")
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	virtualInterface := h.Item.(*directconnect.VirtualInterface)
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Get the resource arn
+	arn := "arn:" + commonColumnData.Partition + ":directconnect:" + region + ":" + commonColumnData.AccountId + ":dxvif/" + *virtualInterface.VirtualInterfaceId
+
+	return arn, nil
+}
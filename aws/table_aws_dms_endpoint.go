@@ -0,0 +1,330 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsDmsEndpoint(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_dms_endpoint",
+		Description: "AWS DMS Endpoint",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"InvalidParameterValueException", "ResourceNotFoundFault", "InvalidParameterCombinationException"}),
+			},
+			Hydrate: getDmsEndpoint,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listDmsEndpoints,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "endpoint_identifier",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "endpoint_type",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "engine_name",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "endpoint_identifier",
+				Description: "The database endpoint identifier.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) string that uniquely identifies the endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EndpointArn"),
+			},
+			{
+				Name:        "endpoint_type",
+				Description: "The type of endpoint, either SOURCE or TARGET.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "engine_name",
+				Description: "The database engine name, e.g. mysql, oracle, postgres, aurora, redshift, s3, dynamodb or mongodb.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "engine_display_name",
+				Description: "The expanded name for the engine name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "server_name",
+				Description: "The name of the server at the endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "port",
+				Description: "The port value used to access the endpoint.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "database_name",
+				Description: "The name of the database at the endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "username",
+				Description: "The user name used to connect to the endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ssl_mode",
+				Description: "The SSL mode used to connect to the endpoint, either none, require, verify-ca or verify-full.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "certificate_arn",
+				Description: "The Amazon Resource Name (ARN) used for SSL connection to the endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kms_key_id",
+				Description: "An AWS KMS key identifier that is used to encrypt the connection parameters for the endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_access_role_arn",
+				Description: "The Amazon Resource Name (ARN) used by the service to access the IAM role.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "external_id",
+				Description: "Value returned by a call to CreateEndpoint that can be used for cross-account validation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "extra_connection_attributes",
+				Description: "Additional connection attributes used to connect to the endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "settings",
+				Description: "The engine specific settings of the endpoint, keyed by settings type, e.g. MySQLSettings or S3Settings. Passwords are not included.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(dmsEndpointSettings),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags currently associated with the endpoint.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDmsEndpointTags,
+				Transform:   transform.FromField("TagList"),
+			},
+
+			// Steampipe Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EndpointIdentifier"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDmsEndpointTags,
+				Transform:   transform.From(dmsReplicationInstanceTagListToTagsMap),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("EndpointArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDmsEndpoints(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := DatabaseMigrationService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the params
+	input := &databasemigrationservice.DescribeEndpointsInput{
+		MaxRecords: aws.Int64(100),
+	}
+
+	var filter []*databasemigrationservice.Filter
+
+	// Additonal Filter
+	equalQuals := d.KeyColumnQuals
+	if equalQuals["endpoint_identifier"] != nil {
+		paramFilter := &databasemigrationservice.Filter{
+			Name:   aws.String("endpoint-id"),
+			Values: []*string{aws.String(equalQuals["endpoint_identifier"].GetStringValue())},
+		}
+		filter = append(filter, paramFilter)
+	}
+	if equalQuals["endpoint_type"] != nil {
+		paramFilter := &databasemigrationservice.Filter{
+			Name:   aws.String("endpoint-type"),
+			Values: []*string{aws.String(equalQuals["endpoint_type"].GetStringValue())},
+		}
+		filter = append(filter, paramFilter)
+	}
+	if equalQuals["engine_name"] != nil {
+		paramFilter := &databasemigrationservice.Filter{
+			Name:   aws.String("engine-name"),
+			Values: []*string{aws.String(equalQuals["engine_name"].GetStringValue())},
+		}
+		filter = append(filter, paramFilter)
+	}
+	input.Filters = filter
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxRecords {
+			if *limit < 20 {
+				input.MaxRecords = aws.Int64(20)
+			} else {
+				input.MaxRecords = limit
+			}
+		}
+	}
+
+	// List call
+	err = svc.DescribeEndpointsPages(
+		input,
+		func(page *databasemigrationservice.DescribeEndpointsOutput, isLast bool) bool {
+			for _, endpoint := range page.Endpoints {
+				d.StreamListItem(ctx, endpoint)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDmsEndpoint(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service
+	svc, err := DatabaseMigrationService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	arn := d.KeyColumnQuals["arn"].GetStringValue()
+
+	params := &databasemigrationservice.DescribeEndpointsInput{
+		Filters: []*databasemigrationservice.Filter{
+			{
+				Name:   aws.String("endpoint-arn"),
+				Values: []*string{aws.String(arn)},
+			},
+		},
+	}
+
+	op, err := svc.DescribeEndpoints(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(op.Endpoints) > 0 {
+		return op.Endpoints[0], nil
+	}
+	return nil, nil
+}
+
+func getDmsEndpointTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getDmsEndpointTags")
+
+	endpointArn := h.Item.(*databasemigrationservice.Endpoint).EndpointArn
+
+	// Create service
+	svc, err := DatabaseMigrationService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &databasemigrationservice.ListTagsForResourceInput{
+		ResourceArn: endpointArn,
+	}
+
+	endpointTags, err := svc.ListTagsForResource(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return endpointTags, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// dmsEndpointSettings collects the engine specific settings of the endpoint,
+// dropping the password fields some of the settings types carry
+func dmsEndpointSettings(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	endpoint := d.HydrateItem.(*databasemigrationservice.Endpoint)
+
+	data, err := json.Marshal(endpoint)
+	if err != nil {
+		plugin.Logger(ctx).Error("dmsEndpointSettings", "marshal_error", err)
+		return nil, err
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		plugin.Logger(ctx).Error("dmsEndpointSettings", "unmarshal_error", err)
+		return nil, err
+	}
+
+	settings := map[string]interface{}{}
+	for name, value := range fields {
+		values, ok := value.(map[string]interface{})
+		if !ok || !strings.HasSuffix(name, "Settings") {
+			continue
+		}
+		for key := range values {
+			// SecurityDbEncryption holds the Oracle TDE password
+			if strings.Contains(key, "Password") || key == "SecurityDbEncryption" {
+				delete(values, key)
+			}
+		}
+		settings[name] = values
+	}
+
+	if len(settings) == 0 {
+		return nil, nil
+	}
+	return settings, nil
+}
@@ -0,0 +1,356 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsDocDBCluster(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_docdb_cluster",
+		Description: "AWS DocumentDB Cluster",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("db_cluster_identifier"),
+			Hydrate:    getDocDBCluster,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"DBClusterNotFoundFault"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listDocDBClusters,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "db_cluster_identifier",
+				Description: "Contains a user-supplied DB cluster identifier. This identifier is the unique key that identifies a DB cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBClusterIdentifier"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) for the DB cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBClusterArn"),
+			},
+			{
+				Name:        "status",
+				Description: "Specifies the current state of this DB cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cluster_create_time",
+				Description: "Specifies the time when the DB cluster was created, in Universal Coordinated Time (UTC).",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "backup_retention_period",
+				Description: "Specifies the number of days for which automatic DB snapshots are retained.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "db_cluster_parameter_group",
+				Description: "Specifies the name of the DB cluster parameter group for the DB cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBClusterParameterGroup"),
+			},
+			{
+				Name:        "db_subnet_group",
+				Description: "Specifies information on the subnet group associated with the DB cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBSubnetGroup"),
+			},
+			{
+				Name:        "db_cluster_resource_id",
+				Description: "The Region-unique, immutable identifier for the DB cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "deletion_protection",
+				Description: "Indicates whether or not the DB cluster has deletion protection enabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "earliest_restorable_time",
+				Description: "Specifies the earliest time to which a database can be restored with point-in-time restore.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "endpoint",
+				Description: "Specifies the connection endpoint for the primary instance of the DB cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "engine",
+				Description: "Provides the name of the database engine to be used for this DB cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "engine_version",
+				Description: "Indicates the database engine version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "hosted_zone_id",
+				Description: "Specifies the ID that Amazon Route 53 assigns when you create a hosted zone.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kms_key_id",
+				Description: "If StorageEncrypted is true, the KMS key identifier for the encrypted DB cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "latest_restorable_time",
+				Description: "Specifies the latest time to which a database can be restored with point-in-time restore.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "master_username",
+				Description: "Contains the master user name for the DB cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "multi_az",
+				Description: "Specifies whether the DB cluster has instances in multiple Availability Zones.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("MultiAZ"),
+			},
+			{
+				Name:        "percent_progress",
+				Description: "Specifies the progress of the operation as a percentage.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "port",
+				Description: "Specifies the port that the database engine is listening on.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "preferred_backup_window",
+				Description: "Specifies the daily time range during which automated backups are created if automated backups are enabled, as determined by the BackupRetentionPeriod.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "preferred_maintenance_window",
+				Description: "Specifies the weekly time range during which system maintenance can occur, in Universal Coordinated Time (UTC).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "reader_endpoint",
+				Description: "The reader endpoint for the DB cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "replication_source_identifier",
+				Description: "Contains the identifier of the source DB cluster if this DB cluster is a secondary cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "storage_encrypted",
+				Description: "Specifies whether the DB cluster is encrypted.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "associated_roles",
+				Description: "Provides a list of the Identity and Access Management (IAM) roles that are associated with the DB cluster.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "availability_zones",
+				Description: "Provides the list of EC2 Availability Zones that instances in the DB cluster can be created in.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "db_cluster_members",
+				Description: "Provides the list of instances that make up the DB cluster.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DBClusterMembers"),
+			},
+			{
+				Name:        "enabled_cloudwatch_logs_exports",
+				Description: "A list of log types that this DB cluster is configured to export to CloudWatch Logs.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "read_replica_identifiers",
+				Description: "Contains one or more identifiers of the secondary clusters that are associated with this DB cluster.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "vpc_security_groups",
+				Description: "Provides a list of VPC security groups that the DB cluster belongs to.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the resource.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDocDBResourceTags,
+				Transform:   transform.FromField("TagList"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBClusterIdentifier"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDocDBResourceTags,
+				Transform:   transform.From(docDBTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DBClusterArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDocDBClusters(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listDocDBClusters")
+
+	// Create session
+	svc, err := DocDBService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// The DocumentDB API also returns the clusters of other RDS engines, e.g.
+	// Aurora and Neptune, so restrict the list to DocumentDB clusters
+	input := &docdb.DescribeDBClustersInput{
+		MaxRecords: aws.Int64(100),
+		Filters: []*docdb.Filter{
+			{
+				Name:   aws.String("engine"),
+				Values: []*string{aws.String("docdb")},
+			},
+		},
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxRecords {
+			if *limit < 20 {
+				input.MaxRecords = aws.Int64(20)
+			} else {
+				input.MaxRecords = limit
+			}
+		}
+	}
+
+	// List call
+	err = svc.DescribeDBClustersPages(
+		input,
+		func(page *docdb.DescribeDBClustersOutput, isLast bool) bool {
+			for _, dbCluster := range page.DBClusters {
+				d.StreamListItem(ctx, dbCluster)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDocDBCluster(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	logger.Trace("getDocDBCluster")
+
+	identifier := d.KeyColumnQuals["db_cluster_identifier"].GetStringValue()
+
+	// Create session
+	svc, err := DocDBService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the params
+	params := &docdb.DescribeDBClustersInput{
+		DBClusterIdentifier: aws.String(identifier),
+	}
+
+	// Get call
+	data, err := svc.DescribeDBClusters(params)
+	if err != nil {
+		logger.Error("getDocDBCluster", "ERROR", err)
+		return nil, err
+	}
+	if len(data.DBClusters) > 0 && *data.DBClusters[0].Engine == "docdb" {
+		return data.DBClusters[0], nil
+	}
+	return nil, nil
+}
+
+func getDocDBResourceTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	logger.Trace("getDocDBResourceTags")
+
+	var arn *string
+	switch item := h.Item.(type) {
+	case *docdb.DBCluster:
+		arn = item.DBClusterArn
+	case *docdb.DBInstance:
+		arn = item.DBInstanceArn
+	}
+
+	// Create session
+	svc, err := DocDBService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &docdb.ListTagsForResourceInput{
+		ResourceName: arn,
+	}
+
+	tags, err := svc.ListTagsForResource(input)
+	if err != nil {
+		logger.Error("getDocDBResourceTags", "ERROR", err)
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func docDBTurbotTags(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("docDBTurbotTags")
+	tagsDetails := d.HydrateItem.(*docdb.ListTagsForResourceOutput)
+
+	// Mapping the resource tags inside turbotTags
+	var turbotTagsMap map[string]string
+	if tagsDetails != nil && len(tagsDetails.TagList) > 0 {
+		turbotTagsMap = map[string]string{}
+		for _, i := range tagsDetails.TagList {
+			turbotTagsMap[*i.Key] = *i.Value
+		}
+	}
+
+	return turbotTagsMap, nil
+}
@@ -0,0 +1,301 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsDocDBClusterInstance(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_docdb_cluster_instance",
+		Description: "AWS DocumentDB Cluster Instance",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("db_instance_identifier"),
+			Hydrate:    getDocDBClusterInstance,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"DBInstanceNotFound"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listDocDBClusterInstances,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "db_cluster_identifier", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "db_instance_identifier",
+				Description: "Contains a user-provided database identifier. This identifier is the unique key that identifies an instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBInstanceIdentifier"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) for the instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBInstanceArn"),
+			},
+			{
+				Name:        "db_cluster_identifier",
+				Description: "Contains the name of the cluster that the instance is a member of.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBClusterIdentifier"),
+			},
+			{
+				Name:        "db_instance_status",
+				Description: "Specifies the current state of this database.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBInstanceStatus"),
+			},
+			{
+				Name:        "db_instance_class",
+				Description: "Contains the name of the compute and memory capacity class of the instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBInstanceClass"),
+			},
+			{
+				Name:        "dbi_resource_id",
+				Description: "The Region-unique, immutable identifier for the instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "availability_zone",
+				Description: "Specifies the name of the Availability Zone that the instance is located in.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "auto_minor_version_upgrade",
+				Description: "Indicates that minor version patches are applied automatically.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "backup_retention_period",
+				Description: "Specifies the number of days for which automatic snapshots are retained.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "ca_certificate_identifier",
+				Description: "The identifier of the CA certificate for this DB instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CACertificateIdentifier"),
+			},
+			{
+				Name:        "engine",
+				Description: "Provides the name of the database engine to be used for this instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "engine_version",
+				Description: "Indicates the database engine version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "endpoint_address",
+				Description: "Specifies the DNS address of the instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Endpoint.Address"),
+			},
+			{
+				Name:        "endpoint_port",
+				Description: "Specifies the port that the database engine is listening on.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Endpoint.Port"),
+			},
+			{
+				Name:        "instance_create_time",
+				Description: "Provides the date and time that the instance was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "kms_key_id",
+				Description: "If StorageEncrypted is true, the KMS key identifier for the encrypted instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "latest_restorable_time",
+				Description: "Specifies the latest time to which a database can be restored with point-in-time restore.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "preferred_backup_window",
+				Description: "Specifies the daily time range during which automated backups are created if automated backups are enabled.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "preferred_maintenance_window",
+				Description: "Specifies the weekly time range during which system maintenance can occur, in Universal Coordinated Time (UTC).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "promotion_tier",
+				Description: "A value that specifies the order in which a replica is promoted to the primary instance after a failure of the existing primary instance.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "publicly_accessible",
+				Description: "Specifies the availability options for the instance.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "storage_encrypted",
+				Description: "Specifies whether or not the instance is encrypted.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "db_subnet_group",
+				Description: "Specifies information on the subnet group that is associated with the instance.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DBSubnetGroup"),
+			},
+			{
+				Name:        "enabled_cloudwatch_logs_exports",
+				Description: "A list of log types that this instance is configured to export to CloudWatch Logs.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "pending_modified_values",
+				Description: "Specifies that changes to the instance are pending.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "status_infos",
+				Description: "The status of a read replica.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "vpc_security_groups",
+				Description: "Provides a list of VPC security group elements that the instance belongs to.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the resource.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDocDBResourceTags,
+				Transform:   transform.FromField("TagList"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBInstanceIdentifier"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDocDBResourceTags,
+				Transform:   transform.From(docDBTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DBInstanceArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDocDBClusterInstances(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listDocDBClusterInstances")
+
+	// Create session
+	svc, err := DocDBService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// The DocumentDB API also returns the instances of other RDS engines, e.g.
+	// Aurora and Neptune, so restrict the list to DocumentDB instances
+	input := &docdb.DescribeDBInstancesInput{
+		MaxRecords: aws.Int64(100),
+		Filters: []*docdb.Filter{
+			{
+				Name:   aws.String("engine"),
+				Values: []*string{aws.String("docdb")},
+			},
+		},
+	}
+
+	// Additonal Filter
+	if d.KeyColumnQuals["db_cluster_identifier"] != nil {
+		input.Filters = append(input.Filters, &docdb.Filter{
+			Name:   aws.String("db-cluster-id"),
+			Values: []*string{aws.String(d.KeyColumnQuals["db_cluster_identifier"].GetStringValue())},
+		})
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxRecords {
+			if *limit < 20 {
+				input.MaxRecords = aws.Int64(20)
+			} else {
+				input.MaxRecords = limit
+			}
+		}
+	}
+
+	// List call
+	err = svc.DescribeDBInstancesPages(
+		input,
+		func(page *docdb.DescribeDBInstancesOutput, isLast bool) bool {
+			for _, dbInstance := range page.DBInstances {
+				d.StreamListItem(ctx, dbInstance)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDocDBClusterInstance(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	logger.Trace("getDocDBClusterInstance")
+
+	identifier := d.KeyColumnQuals["db_instance_identifier"].GetStringValue()
+
+	// Create session
+	svc, err := DocDBService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the params
+	params := &docdb.DescribeDBInstancesInput{
+		DBInstanceIdentifier: aws.String(identifier),
+	}
+
+	// Get call
+	data, err := svc.DescribeDBInstances(params)
+	if err != nil {
+		logger.Error("getDocDBClusterInstance", "ERROR", err)
+		return nil, err
+	}
+	if len(data.DBInstances) > 0 && *data.DBInstances[0].Engine == "docdb" {
+		return data.DBInstances[0], nil
+	}
+	return nil, nil
+}
@@ -56,17 +56,17 @@ func tableAwsDynamoDBBackup(_ context.Context) *plugin.Table {
 			},
 			{
 				Name:        "table_name",
-				Description: "Unique identifier for the table to which backup belongs.",
+				Description: "Name of the table to which backup belongs.",
 				Type:        proto.ColumnType_STRING,
 			},
 			{
 				Name:        "table_arn",
-				Description: "Name of the table to which backup belongs.",
+				Description: "ARN associated with the table to which backup belongs.",
 				Type:        proto.ColumnType_STRING,
 			},
 			{
 				Name:        "table_id",
-				Description: "ARN associated with the table to which backup belongs.",
+				Description: "Unique identifier for the table to which backup belongs.",
 				Type:        proto.ColumnType_STRING,
 			},
 			{
@@ -150,24 +150,29 @@ func listDynamodbBackups(ctx context.Context, d *plugin.QueryData, _ *plugin.Hyd
 		}
 	}
 
-	// Pagination not supported as of date
-	results, err := svc.ListBackups(input)
-	if err != nil {
-		return nil, err
-	}
+	// The SDK has no paginator for ListBackups, so follow LastEvaluatedBackupArn manually
+	for {
+		results, err := svc.ListBackups(input)
+		if err != nil {
+			return nil, err
+		}
 
-	if results.BackupSummaries != nil {
 		for _, backup := range results.BackupSummaries {
 			d.StreamListItem(ctx, backup)
 
 			// Context can be cancelled due to manual cancellation or the limit has been hit
 			if d.QueryStatus.RowsRemaining(ctx) == 0 {
-				break
+				return nil, nil
 			}
 		}
+
+		if results.LastEvaluatedBackupArn == nil {
+			break
+		}
+		input.ExclusiveStartBackupArn = results.LastEvaluatedBackupArn
 	}
 
-	return nil, err
+	return nil, nil
 }
 
 //// HYDRATE FUNCTIONS
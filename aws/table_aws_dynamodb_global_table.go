@@ -112,20 +112,31 @@ func listDynamboDbGlobalTables(ctx context.Context, d *plugin.QueryData, _ *plug
 		}
 	}
 
-	tables, err := svc.ListGlobalTables(input)
+	// The SDK has no paginator for ListGlobalTables, so follow LastEvaluatedGlobalTableName manually
+	for {
+		tables, err := svc.ListGlobalTables(input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, globalTable := range tables.GlobalTables {
+			d.StreamListItem(ctx, &dynamodb.GlobalTableDescription{
+				GlobalTableName: globalTable.GlobalTableName,
+			})
 
-	for _, globalTable := range tables.GlobalTables {
-		d.StreamListItem(ctx, &dynamodb.GlobalTableDescription{
-			GlobalTableName: globalTable.GlobalTableName,
-		})
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
 
-		// Context can be cancelled due to manual cancellation or the limit has been hit
-		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+		if tables.LastEvaluatedGlobalTableName == nil {
 			break
 		}
+		input.ExclusiveStartGlobalTableName = tables.LastEvaluatedGlobalTableName
 	}
 
-	return nil, err
+	return nil, nil
 }
 
 //// HYDRATE FUNCTIONS
@@ -0,0 +1,249 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+func tableAwsDynamoDBTableExport(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_dynamodb_table_export",
+		Description: "AWS DynamoDB Table Export",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ExportNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getDynamodbTableExport,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listDynamodbTableExports,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "table_arn",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the table export.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExportArn"),
+			},
+			{
+				Name:        "export_status",
+				Description: "Export can be in one of the following states: IN_PROGRESS, COMPLETED, or FAILED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "table_arn",
+				Description: "The Amazon Resource Name (ARN) of the table that was exported.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDynamodbTableExport,
+			},
+			{
+				Name:        "table_id",
+				Description: "Unique ID of the table that was exported.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDynamodbTableExport,
+			},
+			{
+				Name:        "billed_size_bytes",
+				Description: "The billable size of the table export.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getDynamodbTableExport,
+			},
+			{
+				Name:        "item_count",
+				Description: "The number of items exported.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getDynamodbTableExport,
+			},
+			{
+				Name:        "client_token",
+				Description: "The client token that was provided for the export task.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDynamodbTableExport,
+			},
+			{
+				Name:        "start_time",
+				Description: "The time at which the export task began.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getDynamodbTableExport,
+			},
+			{
+				Name:        "end_time",
+				Description: "The time at which the export task completed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getDynamodbTableExport,
+			},
+			{
+				Name:        "export_time",
+				Description: "Point in time from which table data was exported.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getDynamodbTableExport,
+			},
+			{
+				Name:        "export_format",
+				Description: "The format of the exported data (DYNAMODB_JSON | ION).",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDynamodbTableExport,
+			},
+			{
+				Name:        "export_manifest",
+				Description: "The name of the manifest file for the export task.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDynamodbTableExport,
+			},
+			{
+				Name:        "failure_code",
+				Description: "Status code for the result of the failed export.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDynamodbTableExport,
+			},
+			{
+				Name:        "failure_message",
+				Description: "Export failure reason description.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDynamodbTableExport,
+			},
+			{
+				Name:        "s3_bucket",
+				Description: "The name of the Amazon S3 bucket containing the export.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDynamodbTableExport,
+			},
+			{
+				Name:        "s3_bucket_owner",
+				Description: "The ID of the AWS account that owns the bucket containing the export.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDynamodbTableExport,
+			},
+			{
+				Name:        "s3_prefix",
+				Description: "The Amazon S3 bucket prefix used as the file name and path of the exported snapshot.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDynamodbTableExport,
+			},
+			{
+				Name:        "s3_sse_algorithm",
+				Description: "Type of encryption used on the bucket where export data is stored (AES256 | KMS).",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDynamodbTableExport,
+			},
+			{
+				Name:        "s3_sse_kms_key_id",
+				Description: "The ID of the AWS KMS managed key used to encrypt the S3 bucket where export data is stored.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDynamodbTableExport,
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExportArn"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ExportArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDynamodbTableExports(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := DynamoDbService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &dynamodb.ListExportsInput{
+		MaxResults: aws.Int64(25),
+	}
+
+	// Additonal Filter
+	equalQuals := d.KeyColumnQuals
+	if equalQuals["table_arn"] != nil {
+		input.TableArn = aws.String(equalQuals["table_arn"].GetStringValue())
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxResults {
+			if *limit < 1 {
+				input.MaxResults = types.Int64(1)
+			} else {
+				input.MaxResults = limit
+			}
+		}
+	}
+
+	err = svc.ListExportsPages(
+		input,
+		func(page *dynamodb.ListExportsOutput, isLast bool) bool {
+			for _, export := range page.ExportSummaries {
+				d.StreamListItem(ctx, &dynamodb.ExportDescription{
+					ExportArn:    export.ExportArn,
+					ExportStatus: export.ExportStatus,
+				})
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDynamodbTableExport(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getDynamodbTableExport")
+
+	var arn string
+	if h.Item != nil {
+		data := h.Item.(*dynamodb.ExportDescription)
+		arn = types.SafeString(data.ExportArn)
+	} else {
+		arn = d.KeyColumnQuals["arn"].GetStringValue()
+	}
+
+	// Create Session
+	svc, err := DynamoDbService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &dynamodb.DescribeExportInput{
+		ExportArn: aws.String(arn),
+	}
+
+	item, err := svc.DescribeExport(params)
+	if err != nil {
+		plugin.Logger(ctx).Debug("getDynamodbTableExport__", "ERROR", err)
+		return nil, err
+	}
+
+	return item.ExportDescription, nil
+}
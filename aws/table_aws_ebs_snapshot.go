@@ -2,6 +2,7 @@ package aws
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/turbot/go-kit/types"
 	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
@@ -191,6 +192,12 @@ func listAwsEBSSnapshots(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 		MaxResults: aws.Int64(1000),
 	}
 
+	// Only list the snapshots owned by the account unless another owner is
+	// requested, to avoid scanning every public snapshot in the region
+	if d.KeyColumnQuals["owner_id"] == nil && d.KeyColumnQuals["owner_alias"] == nil {
+		input.OwnerIds = []*string{aws.String("self")}
+	}
+
 	// Build filter for ebs snapshot
 	filters := buildEbsSnapshotFilter(ctx, d, h, d.KeyColumnQuals)
 	input.Filters = filters
@@ -222,6 +229,9 @@ func listAwsEBSSnapshots(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 			return !isLast
 		},
 	)
+	if err != nil {
+		plugin.Logger(ctx).Error("listAwsEBSSnapshots", "DescribeSnapshotsPages", err)
+	}
 
 	return nil, err
 }
@@ -338,7 +348,9 @@ func buildEbsSnapshotFilter(ctx context.Context, d *plugin.QueryData, h *plugin.
 				Name: types.String(filterName),
 			}
 			value := equalQuals[columnName]
-			if value.GetStringValue() != "" {
+			if _, ok := value.Value.(*proto.QualValue_BoolValue); ok {
+				filter.Values = []*string{types.String(fmt.Sprint(value.GetBoolValue()))}
+			} else if value.GetStringValue() != "" {
 				filter.Values = []*string{types.String(equalQuals[columnName].GetStringValue())}
 			} else if value.GetListValue() != nil {
 				filter.Values = getListValues(value.GetListValue())
@@ -121,6 +121,11 @@ func tableAwsEBSVolume(_ context.Context) *plugin.Table {
 				Description: "The snapshot from which the volume was created, if applicable.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "throughput",
+				Description: "The throughput that the volume supports, in MiB/s.",
+				Type:        proto.ColumnType_INT,
+			},
 			{
 				Name:        "attachments",
 				Description: "Information about the volume attachments.",
@@ -31,6 +31,7 @@ func tableAwsEc2Ami(_ context.Context) *plugin.Table {
 				{Name: "description", Require: plugin.Optional},
 				{Name: "ena_support", Require: plugin.Optional, Operators: []string{"=", "<>"}},
 				{Name: "hypervisor", Require: plugin.Optional},
+				{Name: "image_id", Require: plugin.Optional},
 				{Name: "image_type", Require: plugin.Optional},
 				{Name: "public", Require: plugin.Optional, Operators: []string{"=", "<>"}},
 				{Name: "kernel_id", Require: plugin.Optional},
@@ -82,6 +83,11 @@ func tableAwsEc2Ami(_ context.Context) *plugin.Table {
 				Description: "The architecture of the image.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "deprecation_time",
+				Description: "The date and time to deprecate the AMI.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
 			{
 				Name:        "description",
 				Description: "The description of the AMI that was provided during image creation.",
@@ -226,6 +232,11 @@ func listEc2Amis(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 	}
 
 	resp, err := svc.DescribeImages(input)
+	if err != nil {
+		plugin.Logger(ctx).Error("listEc2Amis", "api_error", err)
+		return nil, err
+	}
+
 	for _, image := range resp.Images {
 		d.StreamListItem(ctx, image)
 
@@ -234,7 +245,7 @@ func listEc2Amis(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 			return nil, nil
 		}
 	}
-	return nil, err
+	return nil, nil
 }
 
 //// HYDRATE FUNCTIONS
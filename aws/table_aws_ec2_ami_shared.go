@@ -272,7 +272,7 @@ func buildAmisWithOwnerFilter(quals plugin.KeyColumnQualMap, amiType string, ctx
 		"description":         "description",
 		"ena_support":         "ena-support",
 		"hypervisor":          "hypervisor",
-		"image_id ":           "image-id ",
+		"image_id":            "image-id",
 		"image_type":          "image-type",
 		"kernel_id":           "kernel-id",
 		"name":                "name",
@@ -172,8 +172,10 @@ func listEc2ApplicationLoadBalancers(ctx context.Context, d *plugin.QueryData, _
 	equalQuals := d.KeyColumnQuals
 	if equalQuals["name"] != nil {
 		input.Names = []*string{aws.String(equalQuals["name"].GetStringValue())}
+	} else if equalQuals["arn"] != nil {
+		input.LoadBalancerArns = []*string{aws.String(equalQuals["arn"].GetStringValue())}
 	} else {
-		// If the names will be provided in param then page limit cannot be set, API throws an error
+		// If the names or ARNs will be provided in param then page limit cannot be set, API throws an error
 		// ValidationError: Pagination is not supported when specifying load balancers
 		input.PageSize = aws.Int64(400)
 		// Limiting the results
@@ -189,10 +191,6 @@ func listEc2ApplicationLoadBalancers(ctx context.Context, d *plugin.QueryData, _
 		}
 	}
 
-	if equalQuals["arn"] != nil {
-		input.LoadBalancerArns = []*string{aws.String(equalQuals["arn"].GetStringValue())}
-	}
-
 	// List call
 	err = svc.DescribeLoadBalancersPages(
 		input,
@@ -211,6 +209,10 @@ func listEc2ApplicationLoadBalancers(ctx context.Context, d *plugin.QueryData, _
 			return !isLast
 		},
 	)
+	if err != nil {
+		plugin.Logger(ctx).Error("listEc2ApplicationLoadBalancers", "DescribeLoadBalancersPages", err)
+	}
+
 	return nil, err
 }
 
@@ -150,11 +150,17 @@ func tableAwsEc2ClassicLoadBalancer(_ context.Context) *plugin.Table {
 				Transform:   transform.FromField("HealthCheck.HealthyThreshold"),
 			},
 			{
-				Name:        "heath_check_target",
+				Name:        "health_check_target",
 				Description: "The instance being checked.",
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("HealthCheck.Target"),
 			},
+			{
+				Name:        "heath_check_target",
+				Description: "[DEPRECATED] This column has been deprecated and will be removed in a future release, use health_check_target instead. The instance being checked.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HealthCheck.Target"),
+			},
 			{
 				Name:        "source_security_group_name",
 				Description: "The name of the security group.",
@@ -301,6 +307,10 @@ func listEc2ClassicLoadBalancers(ctx context.Context, d *plugin.QueryData, _ *pl
 			return !isLast
 		},
 	)
+	if err != nil {
+		plugin.Logger(ctx).Error("listEc2ClassicLoadBalancers", "DescribeLoadBalancersPages", err)
+	}
+
 	return nil, err
 }
 
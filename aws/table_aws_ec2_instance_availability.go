@@ -26,6 +26,10 @@ func tableAwsInstanceAvailability(_ context.Context) *plugin.Table {
 					Name:    "instance_type",
 					Require: plugin.Optional,
 				},
+				{
+					Name:    "location_type",
+					Require: plugin.Optional,
+				},
 			},
 		},
 		Columns: []*plugin.Column{
@@ -83,14 +87,24 @@ func listAwsAvailableInstanceTypes(ctx context.Context, d *plugin.QueryData, h *
 		LocationType: aws.String("region"),
 	}
 
+	equalQuals := d.KeyColumnQuals
+	if equalQuals["location_type"] != nil {
+		input.LocationType = aws.String(equalQuals["location_type"].GetStringValue())
+	}
+
+	// Offerings for availability zones are already scoped to the region of the
+	// service endpoint, so the location filter is only needed for region offerings
 	var filters []*ec2.Filter
-	filters = append(filters, &ec2.Filter{Name: aws.String("location"), Values: []*string{region.RegionName}})
+	if *input.LocationType == "region" {
+		filters = append(filters, &ec2.Filter{Name: aws.String("location"), Values: []*string{region.RegionName}})
+	}
 
-	equalQuals := d.KeyColumnQuals
 	if equalQuals["instance_type"] != nil {
 		filters = append(filters, &ec2.Filter{Name: aws.String("instance-type"), Values: []*string{aws.String(equalQuals["instance_type"].GetStringValue())}})
 	}
-	input.Filters = filters
+	if len(filters) > 0 {
+		input.Filters = filters
+	}
 
 	// Limiting the results
 	limit := d.QueryContext.Limit
@@ -139,6 +153,13 @@ func getAwsInstanceAvailableAkas(ctx context.Context, d *plugin.QueryData, h *pl
 	}
 	commonColumnData := commonData.(*awsCommonColumnData)
 
-	akas := []string{"arn:" + commonColumnData.Partition + ":ec2:" + *instanceType.Location + "::instance-type/" + *instanceType.InstanceType}
+	// For availability zone offerings the location is not a region, so use the
+	// region the offering was listed from
+	region := *instanceType.Location
+	if parentRegion, ok := h.ParentItem.(*ec2.Region); ok {
+		region = *parentRegion.RegionName
+	}
+
+	akas := []string{"arn:" + commonColumnData.Partition + ":ec2:" + region + "::instance-type/" + *instanceType.InstanceType}
 	return akas, nil
 }
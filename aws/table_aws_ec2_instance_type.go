@@ -99,6 +99,13 @@ func tableAwsInstanceType(_ context.Context) *plugin.Table {
 				Hydrate:     describeInstanceType,
 				Transform:   transform.FromField("InstanceTypes[0].InstanceStorageSupported"),
 			},
+			{
+				Name:        "instance_storage_info",
+				Description: "Describes the instance storage for the instance type, including the total size and disks.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeInstanceType,
+				Transform:   transform.FromField("InstanceTypes[0].InstanceStorageInfo"),
+			},
 			{
 				Name:        "ebs_info",
 				Description: "Describes the Amazon EBS settings for the instance type.",
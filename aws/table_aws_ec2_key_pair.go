@@ -48,6 +48,11 @@ func tableAwsEc2KeyPair(_ context.Context) *plugin.Table {
 				Description: "If key pair was created using CreateKeyPair, this is the SHA-1 digest of the DER encoded private key. If key pair was created using ImportKeyPair to provide AWS the public key, this is the MD5 public key fingerprint as specified in section 4 of RFC4716",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "key_type",
+				Description: "The type of key pair, either rsa or ed25519",
+				Type:        proto.ColumnType_STRING,
+			},
 			{
 				Name:        "tags_src",
 				Description: "A list of tags assigned to the key pair",
@@ -98,6 +103,10 @@ func listEc2KeyPairs(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrate
 	}
 
 	resp, err := svc.DescribeKeyPairs(input)
+	if err != nil {
+		plugin.Logger(ctx).Error("listEc2KeyPairs", "api_error", err)
+		return nil, err
+	}
 
 	for _, keyPair := range resp.KeyPairs {
 		d.StreamListItem(ctx, keyPair)
@@ -107,7 +116,7 @@ func listEc2KeyPairs(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrate
 			return nil, nil
 		}
 	}
-	return nil, err
+	return nil, nil
 }
 
 //// HYDRATE FUNCTIONS
@@ -0,0 +1,223 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEc2LaunchTemplate(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ec2_launch_template",
+		Description: "AWS EC2 Launch Template",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("launch_template_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"InvalidLaunchTemplateId.NotFound", "InvalidLaunchTemplateId.Malformed"}),
+			},
+			Hydrate: getEc2LaunchTemplate,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listEc2LaunchTemplates,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "launch_template_name", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "launch_template_name",
+				Description: "The name of the launch template.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "launch_template_id",
+				Description: "The ID of the launch template.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the launch template.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getEc2LaunchTemplateARN,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "create_time",
+				Description: "The time the launch template was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "created_by",
+				Description: "The principal that created the launch template.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "default_version_number",
+				Description: "The version number of the default version of the launch template.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "latest_version_number",
+				Description: "The version number of the latest version of the launch template.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the launch template.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LaunchTemplateName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(getEc2LaunchTemplateTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEc2LaunchTemplateARN,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEc2LaunchTemplates(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	plugin.Logger(ctx).Trace("listEc2LaunchTemplates", "AWS_REGION", region)
+
+	// Create session
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// As per API Docs MaxResults value can be between 1 and 200
+	input := &ec2.DescribeLaunchTemplatesInput{
+		MaxResults: aws.Int64(200),
+	}
+
+	filterKeyMap := []VpcFilterKeyMap{
+		{ColumnName: "launch_template_name", FilterName: "launch-template-name", ColumnType: "string"},
+	}
+
+	filters := buildVpcResourcesFilterParameter(filterKeyMap, d.Quals)
+	if len(filters) > 0 {
+		input.Filters = filters
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxResults {
+			if *limit < 1 {
+				input.MaxResults = aws.Int64(1)
+			} else {
+				input.MaxResults = limit
+			}
+		}
+	}
+
+	// List call
+	err = svc.DescribeLaunchTemplatesPages(
+		input,
+		func(page *ec2.DescribeLaunchTemplatesOutput, isLast bool) bool {
+			for _, launchTemplate := range page.LaunchTemplates {
+				d.StreamListItem(ctx, launchTemplate)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("listEc2LaunchTemplates", "DescribeLaunchTemplatesPages", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEc2LaunchTemplate(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getEc2LaunchTemplate")
+
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	launchTemplateID := d.KeyColumnQuals["launch_template_id"].GetStringValue()
+
+	// Empty check
+	if launchTemplateID == "" {
+		return nil, nil
+	}
+
+	// get service
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the params
+	params := &ec2.DescribeLaunchTemplatesInput{
+		LaunchTemplateIds: []*string{aws.String(launchTemplateID)},
+	}
+
+	// Get call
+	op, err := svc.DescribeLaunchTemplates(params)
+	if err != nil {
+		plugin.Logger(ctx).Error("getEc2LaunchTemplate", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.LaunchTemplates) > 0 {
+		return op.LaunchTemplates[0], nil
+	}
+	return nil, nil
+}
+
+func getEc2LaunchTemplateARN(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getEc2LaunchTemplateARN")
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	launchTemplate := h.Item.(*ec2.LaunchTemplate)
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Build ARN
+	arn := "arn:" + commonColumnData.Partition + ":ec2:" + region + ":" + commonColumnData.AccountId + ":launch-template/" + *launchTemplate.LaunchTemplateId
+
+	return arn, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getEc2LaunchTemplateTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	launchTemplate := d.HydrateItem.(*ec2.LaunchTemplate)
+	return ec2TagsToMap(launchTemplate.Tags)
+}
@@ -0,0 +1,209 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEc2LaunchTemplateVersion(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ec2_launch_template_version",
+		Description: "AWS EC2 Launch Template Version",
+		List: &plugin.ListConfig{
+			Hydrate: listEc2LaunchTemplateVersions,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "launch_template_id", Require: plugin.Optional},
+				{Name: "version_number", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"InvalidLaunchTemplateId.NotFound", "InvalidLaunchTemplateId.Malformed", "InvalidLaunchTemplateId.VersionNotFound"}),
+			},
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "launch_template_name",
+				Description: "The name of the launch template.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "launch_template_id",
+				Description: "The ID of the launch template.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "version_number",
+				Description: "The version number.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "version_description",
+				Description: "The description for the version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "default_version",
+				Description: "Indicates whether the version is the default version.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "create_time",
+				Description: "The time the version was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "created_by",
+				Description: "The principal that created the version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "image_id",
+				Description: "The ID of the AMI or Systems Manager parameter used by the version.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LaunchTemplateData.ImageId"),
+			},
+			{
+				Name:        "instance_type",
+				Description: "The instance type.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LaunchTemplateData.InstanceType"),
+			},
+			{
+				Name:        "metadata_options",
+				Description: "The metadata options for the instance, e.g. whether IMDSv2 is required.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LaunchTemplateData.MetadataOptions"),
+			},
+			{
+				Name:        "network_interfaces",
+				Description: "The network interfaces of the instance.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LaunchTemplateData.NetworkInterfaces"),
+			},
+			{
+				Name:        "launch_template_data",
+				Description: "Information about the launch template version data.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(getEc2LaunchTemplateVersionTitle),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEc2LaunchTemplateVersions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	plugin.Logger(ctx).Trace("listEc2LaunchTemplateVersions", "AWS_REGION", region)
+
+	// Create session
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// Versions can only be listed per launch template, so iterate all the
+	// templates in the region unless a launch_template_id is given
+	if launchTemplateID := d.KeyColumnQuals["launch_template_id"].GetStringValue(); launchTemplateID != "" {
+		return nil, listEc2LaunchTemplateVersionsByID(ctx, d, svc, launchTemplateID)
+	}
+
+	var listErr error
+	err = svc.DescribeLaunchTemplatesPages(
+		&ec2.DescribeLaunchTemplatesInput{
+			MaxResults: aws.Int64(200),
+		},
+		func(page *ec2.DescribeLaunchTemplatesOutput, isLast bool) bool {
+			for _, launchTemplate := range page.LaunchTemplates {
+				listErr = listEc2LaunchTemplateVersionsByID(ctx, d, svc, *launchTemplate.LaunchTemplateId)
+				if listErr != nil {
+					// The template may have been deleted since it was listed
+					if a, ok := listErr.(awserr.Error); ok && a.Code() == "InvalidLaunchTemplateId.NotFound" {
+						listErr = nil
+						continue
+					}
+					return false
+				}
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("listEc2LaunchTemplateVersions", "DescribeLaunchTemplatesPages", err)
+		return nil, err
+	}
+
+	return nil, listErr
+}
+
+func listEc2LaunchTemplateVersionsByID(ctx context.Context, d *plugin.QueryData, svc *ec2.EC2, launchTemplateID string) error {
+	// As per API Docs MaxResults value can be between 1 and 200
+	input := &ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String(launchTemplateID),
+		MaxResults:       aws.Int64(200),
+	}
+
+	if d.KeyColumnQuals["version_number"] != nil {
+		input.Versions = []*string{aws.String(fmt.Sprint(d.KeyColumnQuals["version_number"].GetInt64Value()))}
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxResults {
+			if *limit < 1 {
+				input.MaxResults = aws.Int64(1)
+			} else {
+				input.MaxResults = limit
+			}
+		}
+	}
+
+	err := svc.DescribeLaunchTemplateVersionsPages(
+		input,
+		func(page *ec2.DescribeLaunchTemplateVersionsOutput, isLast bool) bool {
+			for _, version := range page.LaunchTemplateVersions {
+				d.StreamListItem(ctx, version)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("listEc2LaunchTemplateVersionsByID", "DescribeLaunchTemplateVersionsPages", err)
+	}
+
+	return err
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getEc2LaunchTemplateVersionTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	version := d.HydrateItem.(*ec2.LaunchTemplateVersion)
+	return fmt.Sprintf("%s:%d", *version.LaunchTemplateName, *version.VersionNumber), nil
+}
@@ -28,6 +28,12 @@ func tableAwsEc2ApplicationLoadBalancerListener(_ context.Context) *plugin.Table
 		List: &plugin.ListConfig{
 			ParentHydrate: listEc2LoadBalancers,
 			Hydrate:       listEc2LoadBalancerListeners,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"LoadBalancerNotFound", "ValidationError"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "load_balancer_arn", Require: plugin.Optional},
+			},
 		},
 		GetMatrixItem: BuildRegionList,
 		Columns: awsRegionalColumns([]*plugin.Column{
@@ -99,9 +105,17 @@ func listEc2LoadBalancers(ctx context.Context, d *plugin.QueryData, _ *plugin.Hy
 		return nil, err
 	}
 
+	input := &elbv2.DescribeLoadBalancersInput{}
+
+	// Only the listeners of the given load balancer are required
+	loadBalancerArn := d.KeyColumnQuals["load_balancer_arn"].GetStringValue()
+	if loadBalancerArn != "" {
+		input.LoadBalancerArns = []*string{aws.String(loadBalancerArn)}
+	}
+
 	// List call
 	err = svc.DescribeLoadBalancersPages(
-		&elbv2.DescribeLoadBalancersInput{},
+		input,
 		func(page *elbv2.DescribeLoadBalancersOutput, isLast bool) bool {
 			for _, loadBalancer := range page.LoadBalancers {
 				d.StreamListItem(ctx, loadBalancer)
@@ -109,6 +123,10 @@ func listEc2LoadBalancers(ctx context.Context, d *plugin.QueryData, _ *plugin.Hy
 			return !isLast
 		},
 	)
+	if err != nil {
+		plugin.Logger(ctx).Error("listEc2LoadBalancers", "DescribeLoadBalancersPages", err)
+	}
+
 	return nil, err
 }
 
@@ -155,6 +173,10 @@ func listEc2LoadBalancerListeners(ctx context.Context, d *plugin.QueryData, h *p
 			return !isLast
 		},
 	)
+	if err != nil {
+		plugin.Logger(ctx).Error("listEc2LoadBalancerListeners", "DescribeListenersPages", err)
+	}
+
 	return nil, err
 }
 
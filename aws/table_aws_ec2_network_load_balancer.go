@@ -35,6 +35,10 @@ func tableAwsEc2NetworkLoadBalancer(_ context.Context) *plugin.Table {
 					Name:    "name",
 					Require: plugin.Optional,
 				},
+				{
+					Name:    "arn",
+					Require: plugin.Optional,
+				},
 			},
 		},
 		GetMatrixItem: BuildRegionList,
@@ -167,8 +171,10 @@ func listEc2NetworkLoadBalancers(ctx context.Context, d *plugin.QueryData, _ *pl
 	equalQuals := d.KeyColumnQuals
 	if equalQuals["name"] != nil {
 		input.Names = []*string{aws.String(equalQuals["name"].GetStringValue())}
+	} else if equalQuals["arn"] != nil {
+		input.LoadBalancerArns = []*string{aws.String(equalQuals["arn"].GetStringValue())}
 	} else {
-		// If the names will be provided in param then page limit can not be set, api throws error
+		// If the names or ARNs will be provided in param then page limit cannot be set, API throws an error
 		// ValidationError: Pagination is not supported when specifying load balancers
 		input.PageSize = aws.Int64(400)
 		// Limiting the results
@@ -202,6 +208,10 @@ func listEc2NetworkLoadBalancers(ctx context.Context, d *plugin.QueryData, _ *pl
 			return !isLast
 		},
 	)
+	if err != nil {
+		plugin.Logger(ctx).Error("listEc2NetworkLoadBalancers", "DescribeLoadBalancersPages", err)
+	}
+
 	return nil, err
 }
 
@@ -136,6 +136,11 @@ func tableAwsEc2ReservedInstance(_ context.Context) *plugin.Table {
 				Description: "The usage price of the Reserved Instance, per hour.",
 				Type:        proto.ColumnType_DOUBLE,
 			},
+			{
+				Name:        "recurring_charges",
+				Description: "The recurring charges associated with the Reserved Instance.",
+				Type:        proto.ColumnType_JSON,
+			},
 			{
 				Name:        "reserved_instances_modifications",
 				Description: "The Reserved Instance modification information.",
@@ -0,0 +1,178 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEc2SavingsPlanUtilization(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ec2_savings_plan_utilization",
+		Description: "AWS Cost Explorer - Savings Plan Utilization",
+		List: &plugin.ListConfig{
+			Hydrate: listEc2SavingsPlanUtilizations,
+			// The period columns echo the requested dates, so strict operators
+			// would filter out every row
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "period_start", Operators: []string{">=", "="}, Require: plugin.Optional},
+				{Name: "period_end", Operators: []string{"<=", "="}, Require: plugin.Optional},
+			},
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "savings_plan_arn",
+				Description: "The Amazon Resource Name (ARN) of the Savings Plan.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "period_start",
+				Description: "Start timestamp of the period the utilization is aggregated over.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimePeriod.Start"),
+			},
+			{
+				Name:        "period_end",
+				Description: "End timestamp of the period the utilization is aggregated over.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimePeriod.End"),
+			},
+			{
+				Name:        "utilization_percentage",
+				Description: "The percentage of the commitment that was used.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Utilization.UtilizationPercentage"),
+			},
+			{
+				Name:        "total_commitment",
+				Description: "The total commitment for the Savings Plan over the period.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Utilization.TotalCommitment"),
+			},
+			{
+				Name:        "used_commitment",
+				Description: "The amount of the commitment that was used.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Utilization.UsedCommitment"),
+			},
+			{
+				Name:        "unused_commitment",
+				Description: "The amount of the commitment that was not used.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Utilization.UnusedCommitment"),
+			},
+			{
+				Name:        "net_savings",
+				Description: "The savings of the Savings Plan compared to On-Demand, less the commitment.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Savings.NetSavings"),
+			},
+			{
+				Name:        "on_demand_cost_equivalent",
+				Description: "The cost of the usage covered by the Savings Plan at On-Demand rates.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Savings.OnDemandCostEquivalent"),
+			},
+			{
+				Name:        "amortized_recurring_commitment",
+				Description: "The amortized amount of the recurring commitment, for No Upfront and Partial Upfront plans.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("AmortizedCommitment.AmortizedRecurringCommitment"),
+			},
+			{
+				Name:        "amortized_upfront_commitment",
+				Description: "The amortized amount of the upfront commitment, for All Upfront and Partial Upfront plans.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("AmortizedCommitment.AmortizedUpfrontCommitment"),
+			},
+			{
+				Name:        "total_amortized_commitment",
+				Description: "The total amortized commitment of the Savings Plan over the period.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("AmortizedCommitment.TotalAmortizedCommitment"),
+			},
+			{
+				Name:        "attributes",
+				Description: "The attributes of the Savings Plan, e.g. its region, instance family, term and payment option.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SavingsPlanArn"),
+			},
+		}),
+	}
+}
+
+type savingsPlanUtilizationRow struct {
+	*costexplorer.SavingsPlansUtilizationDetail
+	TimePeriod *costexplorer.DateInterval
+}
+
+//// LIST FUNCTION
+
+func listEc2SavingsPlanUtilizations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	logger.Trace("listEc2SavingsPlanUtilizations")
+
+	// The utilization of each plan is aggregated over the whole period
+	params := &costexplorer.GetSavingsPlansUtilizationDetailsInput{
+		TimePeriod: getCETimePeriod("DAILY", d),
+		MaxResults: aws.Int64(100),
+	}
+
+	// The period quals may leave nothing to request, the end of the period is exclusive
+	if *params.TimePeriod.Start >= *params.TimePeriod.End {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := CostExplorerService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// List call
+	for {
+		var output *costexplorer.GetSavingsPlansUtilizationDetailsOutput
+		cacheKey := "costexplorer-" + params.String()
+		if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+			output = cachedData.(*costexplorer.GetSavingsPlansUtilizationDetailsOutput)
+		} else {
+			logger.Info("listEc2SavingsPlanUtilizations", "GetSavingsPlansUtilizationDetails request, charged at $0.01 per request", "time_period", params.TimePeriod.String())
+			output, err = svc.GetSavingsPlansUtilizationDetails(params)
+			if err != nil {
+				logger.Error("listEc2SavingsPlanUtilizations", "GetSavingsPlansUtilizationDetails_error", err)
+				return nil, err
+			}
+			d.ConnectionManager.Cache.SetWithTTL(cacheKey, output, costExplorerCacheTTL)
+		}
+
+		for _, detail := range output.SavingsPlansUtilizationDetails {
+			d.StreamListItem(ctx, savingsPlanUtilizationRow{detail, output.TimePeriod})
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		params.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
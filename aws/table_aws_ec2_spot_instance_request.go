@@ -0,0 +1,291 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEc2SpotInstanceRequest(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ec2_spot_instance_request",
+		Description: "AWS EC2 Spot Instance Request",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("spot_instance_request_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"InvalidSpotInstanceRequestID.NotFound", "InvalidSpotInstanceRequestID.Malformed"}),
+			},
+			Hydrate: getEc2SpotInstanceRequest,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listEc2SpotInstanceRequests,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "state", Require: plugin.Optional},
+				{Name: "status_code", Require: plugin.Optional},
+				{Name: "instance_id", Require: plugin.Optional},
+				{Name: "type", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "spot_instance_request_id",
+				Description: "The ID of the Spot Instance request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the Spot Instance request.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getEc2SpotInstanceRequestARN,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "state",
+				Description: "The state of the Spot Instance request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_code",
+				Description: "The status code of the Spot Instance request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status.Code"),
+			},
+			{
+				Name:        "status_message",
+				Description: "The description of the status code.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status.Message"),
+			},
+			{
+				Name:        "spot_price",
+				Description: "The maximum price per hour that you are willing to pay for a Spot Instance.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("SpotPrice").Transform(transform.ToDouble),
+			},
+			{
+				Name:        "instance_id",
+				Description: "The instance ID, if an instance has been launched to fulfill the Spot Instance request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The Spot Instance request type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The date and time when the Spot Instance request was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "valid_from",
+				Description: "The start date of the request.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "valid_until",
+				Description: "The end date of the request.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "instance_interruption_behavior",
+				Description: "The behavior when a Spot Instance is interrupted.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "launched_availability_zone",
+				Description: "The Availability Zone in which the request is launched.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "product_description",
+				Description: "The product description associated with the Spot Instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "fault",
+				Description: "The fault codes for the Spot Instance request, if any.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "launch_specification",
+				Description: "Additional information for launching instances.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the Spot Instance request.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(getEc2SpotInstanceRequestTurbotTitle),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(getEc2SpotInstanceRequestTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEc2SpotInstanceRequestARN,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEc2SpotInstanceRequests(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	plugin.Logger(ctx).Trace("listEc2SpotInstanceRequests", "AWS_REGION", region)
+
+	// Create session
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// As per API Docs MaxResults value can be between 5 and 1000
+	input := &ec2.DescribeSpotInstanceRequestsInput{
+		MaxResults: aws.Int64(1000),
+	}
+
+	filterKeyMap := []VpcFilterKeyMap{
+		{ColumnName: "state", FilterName: "state", ColumnType: "string"},
+		{ColumnName: "status_code", FilterName: "status-code", ColumnType: "string"},
+		{ColumnName: "instance_id", FilterName: "instance-id", ColumnType: "string"},
+		{ColumnName: "type", FilterName: "type", ColumnType: "string"},
+	}
+
+	filters := buildVpcResourcesFilterParameter(filterKeyMap, d.Quals)
+	if len(filters) > 0 {
+		input.Filters = filters
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxResults {
+			if *limit < 5 {
+				input.MaxResults = aws.Int64(5)
+			} else {
+				input.MaxResults = limit
+			}
+		}
+	}
+
+	// List call
+	err = svc.DescribeSpotInstanceRequestsPages(
+		input,
+		func(page *ec2.DescribeSpotInstanceRequestsOutput, isLast bool) bool {
+			for _, spotInstanceRequest := range page.SpotInstanceRequests {
+				d.StreamListItem(ctx, spotInstanceRequest)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("listEc2SpotInstanceRequests", "DescribeSpotInstanceRequestsPages", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEc2SpotInstanceRequest(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getEc2SpotInstanceRequest")
+
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	spotInstanceRequestID := d.KeyColumnQuals["spot_instance_request_id"].GetStringValue()
+
+	// Empty check
+	if spotInstanceRequestID == "" {
+		return nil, nil
+	}
+
+	// get service
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the params
+	params := &ec2.DescribeSpotInstanceRequestsInput{
+		SpotInstanceRequestIds: []*string{aws.String(spotInstanceRequestID)},
+	}
+
+	// Get call
+	op, err := svc.DescribeSpotInstanceRequests(params)
+	if err != nil {
+		plugin.Logger(ctx).Error("getEc2SpotInstanceRequest", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.SpotInstanceRequests) > 0 {
+		return op.SpotInstanceRequests[0], nil
+	}
+	return nil, nil
+}
+
+func getEc2SpotInstanceRequestARN(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getEc2SpotInstanceRequestARN")
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	spotInstanceRequest := h.Item.(*ec2.SpotInstanceRequest)
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Build ARN
+	arn := "arn:" + commonColumnData.Partition + ":ec2:" + region + ":" + commonColumnData.AccountId + ":spot-instances-request/" + *spotInstanceRequest.SpotInstanceRequestId
+
+	return arn, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getEc2SpotInstanceRequestTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	spotInstanceRequest := d.HydrateItem.(*ec2.SpotInstanceRequest)
+	return ec2TagsToMap(spotInstanceRequest.Tags)
+}
+
+func getEc2SpotInstanceRequestTurbotTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	spotInstanceRequest := d.HydrateItem.(*ec2.SpotInstanceRequest)
+
+	if spotInstanceRequest.Tags != nil {
+		for _, tag := range spotInstanceRequest.Tags {
+			if *tag.Key == "Name" {
+				return tag.Value, nil
+			}
+		}
+	}
+
+	return spotInstanceRequest.SpotInstanceRequestId, nil
+}
@@ -0,0 +1,138 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEc2SpotPrice(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ec2_spot_price",
+		Description: "AWS EC2 Spot Price History",
+		List: &plugin.ListConfig{
+			Hydrate: listEc2SpotPrices,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "availability_zone", Require: plugin.Optional},
+				{Name: "instance_type", Require: plugin.Optional},
+				{Name: "product_description", Require: plugin.Optional},
+				{Name: "timestamp", Require: plugin.Optional, Operators: []string{">", ">=", "=", "<", "<="}},
+			},
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "availability_zone",
+				Description: "The Availability Zone.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance_type",
+				Description: "The instance type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "product_description",
+				Description: "A general description of the AMI, e.g. Linux/UNIX or Windows.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "spot_price",
+				Description: "The Spot price, per hour, at the time of the price change.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("SpotPrice").Transform(transform.ToDouble),
+			},
+			{
+				Name:        "timestamp",
+				Description: "The date and time the Spot price changed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEc2SpotPrices(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	plugin.Logger(ctx).Trace("listEc2SpotPrices", "AWS_REGION", region)
+
+	// Create session
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// As per API Docs MaxResults value can be between 1 and 1000
+	input := &ec2.DescribeSpotPriceHistoryInput{
+		MaxResults: aws.Int64(1000),
+	}
+
+	equalQuals := d.KeyColumnQuals
+	if equalQuals["availability_zone"] != nil {
+		input.AvailabilityZone = aws.String(equalQuals["availability_zone"].GetStringValue())
+	}
+	if equalQuals["instance_type"] != nil {
+		input.InstanceTypes = []*string{aws.String(equalQuals["instance_type"].GetStringValue())}
+	}
+	if equalQuals["product_description"] != nil {
+		input.ProductDescriptions = []*string{aws.String(equalQuals["product_description"].GetStringValue())}
+	}
+
+	// Without a time range the API only returns the most recent price for each
+	// instance type, Availability Zone and product
+	if d.Quals["timestamp"] != nil {
+		for _, q := range d.Quals["timestamp"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case "=":
+				input.StartTime = aws.Time(timestamp)
+				input.EndTime = aws.Time(timestamp)
+			case ">=", ">":
+				input.StartTime = aws.Time(timestamp)
+			case "<", "<=":
+				input.EndTime = aws.Time(timestamp)
+			}
+		}
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxResults {
+			if *limit < 1 {
+				input.MaxResults = aws.Int64(1)
+			} else {
+				input.MaxResults = limit
+			}
+		}
+	}
+
+	// List call
+	err = svc.DescribeSpotPriceHistoryPages(
+		input,
+		func(page *ec2.DescribeSpotPriceHistoryOutput, isLast bool) bool {
+			for _, spotPrice := range page.SpotPriceHistory {
+				d.StreamListItem(ctx, spotPrice)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("listEc2SpotPrices", "DescribeSpotPriceHistoryPages", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,209 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEcrImage(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ecr_image",
+		Description: "AWS ECR Image",
+		List: &plugin.ListConfig{
+			ParentHydrate: listAwsEcrRepositories,
+			Hydrate:       listAwsEcrImages,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"RepositoryNotFoundException"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "repository_name", Require: plugin.Optional},
+				{Name: "registry_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "repository_name",
+				Description: "The name of the repository to which this image belongs.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "image_digest",
+				Description: "The sha256 digest of the image manifest.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "registry_id",
+				Description: "The AWS account ID associated with the registry to which this image belongs.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "image_pushed_at",
+				Description: "The date and time at which the current image was pushed to the repository.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "image_size_in_bytes",
+				Description: "The size, in bytes, of the image in the repository.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "artifact_media_type",
+				Description: "The artifact media type of the image.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "image_manifest_media_type",
+				Description: "The media type of the image manifest.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "image_scan_status",
+				Description: "The current state of the scan.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "image_scan_findings_summary",
+				Description: "A summary of the last completed image scan.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "image_scan_findings",
+				Description: "The findings of the last completed image scan.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsEcrImageScanFindings,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "image_tags",
+				Description: "The list of tags associated with this image.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ImageDigest"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsEcrImages(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	repository := h.Item.(*ecr.Repository)
+
+	// Minimize the API call with the given repository name
+	equalQuals := d.KeyColumnQuals
+	if equalQuals["repository_name"] != nil && equalQuals["repository_name"].GetStringValue() != *repository.RepositoryName {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := EcrService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &ecr.DescribeImagesInput{
+		RepositoryName: repository.RepositoryName,
+		RegistryId:     repository.RegistryId,
+		MaxResults:     aws.Int64(1000),
+	}
+
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxResults {
+			if *limit < 1 {
+				input.MaxResults = aws.Int64(1)
+			} else {
+				input.MaxResults = limit
+			}
+		}
+	}
+
+	// List call
+	err = svc.DescribeImagesPages(
+		input,
+		func(page *ecr.DescribeImagesOutput, isLast bool) bool {
+			for _, image := range page.ImageDetails {
+				d.StreamListItem(ctx, image)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAwsEcrImageScanFindings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	logger.Trace("getAwsEcrImageScanFindings")
+
+	image := h.Item.(*ecr.ImageDetail)
+
+	// Skip images that have never been scanned
+	if image.ImageScanStatus == nil {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := EcrService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the params
+	// As per doc the max result value can be between 1-1000 but as per testing it returns only 100 result per page
+	params := &ecr.DescribeImageScanFindingsInput{
+		RepositoryName: image.RepositoryName,
+		RegistryId:     image.RegistryId,
+		ImageId: &ecr.ImageIdentifier{
+			ImageDigest: image.ImageDigest,
+		},
+		MaxResults: aws.Int64(100),
+	}
+
+	var findings []interface{}
+	err = svc.DescribeImageScanFindingsPages(
+		params,
+		func(page *ecr.DescribeImageScanFindingsOutput, isLast bool) bool {
+			if page.ImageScanFindings != nil {
+				for _, finding := range page.ImageScanFindings.Findings {
+					findings = append(findings, finding)
+				}
+				for _, finding := range page.ImageScanFindings.EnhancedFindings {
+					findings = append(findings, finding)
+				}
+			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		if strings.Contains(err.Error(), "ScanNotFoundException") {
+			return nil, nil
+		}
+		logger.Error("getAwsEcrImageScanFindings", "DescribeImageScanFindingsPages", err)
+		return nil, err
+	}
+
+	return findings, nil
+}
@@ -23,6 +23,20 @@ func tableAwsEcsService(_ context.Context) *plugin.Table {
 			IgnoreConfig: &plugin.IgnoreConfig{
 				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ClusterNotFoundException"}),
 			},
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "cluster_arn",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "launch_type",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "scheduling_strategy",
+					Require: plugin.Optional,
+				},
+			},
 		},
 		GetMatrixItem: BuildRegionList,
 		Columns: awsRegionalColumns([]*plugin.Column{
@@ -216,6 +230,12 @@ func listEcsServices(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 
 	// Get cluster details
 	cluster := h.Item.(*ecs.Cluster)
+	equalQuals := d.KeyColumnQuals
+
+	// Minimize the API call with the given cluster ARN
+	if equalQuals["cluster_arn"] != nil && equalQuals["cluster_arn"].GetStringValue() != *cluster.ClusterArn {
+		return nil, nil
+	}
 
 	// DescribeServices API can describe up to 10 services in a single operation. Default MaxResults is 10 for ListServicesInput
 	input := &ecs.ListServicesInput{
@@ -223,6 +243,13 @@ func listEcsServices(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 		MaxResults: aws.Int64(10),
 	}
 
+	if equalQuals["launch_type"] != nil {
+		input.LaunchType = aws.String(equalQuals["launch_type"].GetStringValue())
+	}
+	if equalQuals["scheduling_strategy"] != nil {
+		input.SchedulingStrategy = aws.String(equalQuals["scheduling_strategy"].GetStringValue())
+	}
+
 	// If the requested number of items is less than the paging max limit
 	// set the limit to that instead
 	limit := d.QueryContext.Limit
@@ -27,6 +27,9 @@ func tableAwsEksAddon(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			ParentHydrate: listEksClusters,
 			Hydrate:       listEksAddons,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "cluster_name", Require: plugin.Optional},
+			},
 		},
 		GetMatrixItem: BuildRegionList,
 		Columns: awsRegionalColumns([]*plugin.Column{
@@ -115,6 +118,11 @@ func listEksAddons(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 	// Get cluster details
 	clusterName := *h.Item.(*eks.Cluster).Name
 
+	// Minimize the API call with the given cluster name
+	if d.KeyColumnQuals["cluster_name"] != nil && d.KeyColumnQuals["cluster_name"].GetStringValue() != clusterName {
+		return nil, nil
+	}
+
 	// Create service
 	svc, err := EksService(ctx, d)
 	if err != nil {
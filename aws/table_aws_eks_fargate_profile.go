@@ -0,0 +1,192 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEksFargateProfile(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_eks_fargate_profile",
+		Description: "AWS EKS Fargate Profile",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"fargate_profile_name", "cluster_name"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFoundException", "InvalidParameterException", "InvalidParameter"}),
+			},
+			Hydrate: getEksFargateProfile,
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listEksClusters,
+			Hydrate:       listEksFargateProfiles,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "cluster_name", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "fargate_profile_name",
+				Description: "The name of the Fargate profile.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The full Amazon Resource Name (ARN) of the Fargate profile.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getEksFargateProfile,
+				Transform:   transform.FromField("FargateProfileArn"),
+			},
+			{
+				Name:        "cluster_name",
+				Description: "The name of the Amazon EKS cluster that the Fargate profile belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the Fargate profile.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getEksFargateProfile,
+			},
+			{
+				Name:        "created_at",
+				Description: "The Unix epoch timestamp in seconds for when the Fargate profile was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getEksFargateProfile,
+			},
+			{
+				Name:        "pod_execution_role_arn",
+				Description: "The Amazon Resource Name (ARN) of the pod execution role to use for pods that match the selectors in the Fargate profile.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getEksFargateProfile,
+			},
+			{
+				Name:        "selectors",
+				Description: "The selectors to match for pods to use this Fargate profile.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEksFargateProfile,
+			},
+			{
+				Name:        "subnets",
+				Description: "The IDs of subnets to launch pods into.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEksFargateProfile,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FargateProfileName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEksFargateProfile,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEksFargateProfile,
+				Transform:   transform.FromField("FargateProfileArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEksFargateProfiles(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get cluster details
+	clusterName := *h.Item.(*eks.Cluster).Name
+
+	// Minimize the API call with the given cluster name
+	if d.KeyColumnQuals["cluster_name"] != nil && d.KeyColumnQuals["cluster_name"].GetStringValue() != clusterName {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := EksService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &eks.ListFargateProfilesInput{
+		ClusterName: &clusterName,
+		MaxResults:  aws.Int64(100),
+	}
+
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxResults {
+			if *limit < 1 {
+				input.MaxResults = aws.Int64(1)
+			} else {
+				input.MaxResults = limit
+			}
+		}
+	}
+
+	err = svc.ListFargateProfilesPages(
+		input,
+		func(page *eks.ListFargateProfilesOutput, _ bool) bool {
+			for _, fargateProfile := range page.FargateProfileNames {
+				d.StreamListItem(ctx, &eks.FargateProfile{
+					FargateProfileName: fargateProfile,
+					ClusterName:        &clusterName,
+				})
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return true
+		},
+	)
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEksFargateProfile(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getEksFargateProfile")
+
+	var clusterName, fargateProfileName string
+	if h.Item != nil {
+		clusterName = *h.Item.(*eks.FargateProfile).ClusterName
+		fargateProfileName = *h.Item.(*eks.FargateProfile).FargateProfileName
+	} else {
+		clusterName = d.KeyColumnQuals["cluster_name"].GetStringValue()
+		fargateProfileName = d.KeyColumnQuals["fargate_profile_name"].GetStringValue()
+	}
+
+	// create service
+	svc, err := EksService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &eks.DescribeFargateProfileInput{
+		ClusterName:        &clusterName,
+		FargateProfileName: &fargateProfileName,
+	}
+
+	op, err := svc.DescribeFargateProfile(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return op.FargateProfile, nil
+}
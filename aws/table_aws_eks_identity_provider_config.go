@@ -25,11 +25,17 @@ func tableAwsEksIdentityProviderConfig(_ context.Context) *plugin.Table {
 		Description: "AWS EKS Identity Provider Config",
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.AllColumns([]string{"name", "type", "cluster_name"}),
-			Hydrate:    getEksIdentityProviderConfig,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFoundException", "InvalidParameterException"}),
+			},
+			Hydrate: getEksIdentityProviderConfig,
 		},
 		List: &plugin.ListConfig{
 			ParentHydrate: listEksClusters,
 			Hydrate:       listEksIdentityProviderConfigs,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "cluster_name", Require: plugin.Optional},
+			},
 		},
 		GetMatrixItem: BuildRegionList,
 		Columns: awsRegionalColumns([]*plugin.Column{
@@ -142,6 +148,11 @@ func listEksIdentityProviderConfigs(ctx context.Context, d *plugin.QueryData, h
 	// Get Eks Cluster details
 	cluster := h.Item.(*eks.Cluster)
 
+	// Minimize the API call with the given cluster name
+	if d.KeyColumnQuals["cluster_name"] != nil && d.KeyColumnQuals["cluster_name"].GetStringValue() != *cluster.Name {
+		return nil, nil
+	}
+
 	// Create service
 	svc, err := EksService(ctx, d)
 	if err != nil {
@@ -140,6 +140,20 @@ func tableAwsElasticBeanstalkEnvironment(_ context.Context) *plugin.Table {
 				Description: "Describes the current tier of this environment.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "configuration_settings",
+				Description: "The configuration option settings of the environment. Values of environment properties are masked since they commonly hold credentials.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsElasticBeanstalkEnvironmentConfigurationSettings,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "managed_actions",
+				Description: "A list of upcoming and in-progress managed actions, such as platform updates, for the environment.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsElasticBeanstalkEnvironmentManagedActions,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "tags_src",
 				Description: "A list of tags assigned to the Repository",
@@ -268,6 +282,73 @@ func getAwsElasticBeanstalkEnvironment(ctx context.Context, d *plugin.QueryData,
 	return nil, nil
 }
 
+func getAwsElasticBeanstalkEnvironmentConfigurationSettings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getAwsElasticBeanstalkEnvironmentConfigurationSettings")
+
+	environment := h.Item.(*elasticbeanstalk.EnvironmentDescription)
+
+	// The list also returns recently terminated environments, which have no
+	// configuration settings left to describe
+	if aws.StringValue(environment.Status) == elasticbeanstalk.EnvironmentStatusTerminated {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := ElasticBeanstalkService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build param
+	params := &elasticbeanstalk.DescribeConfigurationSettingsInput{
+		ApplicationName: environment.ApplicationName,
+		EnvironmentName: environment.EnvironmentName,
+	}
+
+	op, err := svc.DescribeConfigurationSettings(params)
+	if err != nil {
+		plugin.Logger(ctx).Error("getAwsElasticBeanstalkEnvironmentConfigurationSettings", "DescribeConfigurationSettings_error", err)
+		return nil, err
+	}
+
+	// Environment properties are passed to the application as environment
+	// variables and often hold secrets, so only their names are returned
+	for _, configuration := range op.ConfigurationSettings {
+		for _, option := range configuration.OptionSettings {
+			if option.Namespace != nil && *option.Namespace == "aws:elasticbeanstalk:application:environment" && option.Value != nil {
+				option.Value = aws.String("********")
+			}
+		}
+	}
+
+	return op.ConfigurationSettings, nil
+}
+
+func getAwsElasticBeanstalkEnvironmentManagedActions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getAwsElasticBeanstalkEnvironmentManagedActions")
+
+	environmentId := h.Item.(*elasticbeanstalk.EnvironmentDescription).EnvironmentId
+
+	// Create session
+	svc, err := ElasticBeanstalkService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build param
+	params := &elasticbeanstalk.DescribeEnvironmentManagedActionsInput{
+		EnvironmentId: environmentId,
+	}
+
+	op, err := svc.DescribeEnvironmentManagedActions(params)
+	if err != nil {
+		plugin.Logger(ctx).Error("getAwsElasticBeanstalkEnvironmentManagedActions", "DescribeEnvironmentManagedActions_error", err)
+		return nil, err
+	}
+
+	return op.ManagedActions, nil
+}
+
 func listElasticBeanstalkEnvironmentTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	logger := plugin.Logger(ctx)
 	plugin.Logger(ctx).Trace("listElasticBeanstalkEnvironmentTags")
@@ -0,0 +1,88 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEmrBlockPublicAccessConfiguration(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_emr_block_public_access_configuration",
+		Description: "AWS EMR Block Public Access Configuration",
+		List: &plugin.ListConfig{
+			Hydrate: listEmrBlockPublicAccessConfigurations,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "block_public_security_group_rules",
+				Description: "Indicates whether Amazon EMR block public access is enabled (true) or disabled (false).",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("BlockPublicAccessConfiguration.BlockPublicSecurityGroupRules"),
+			},
+			{
+				Name:        "created_by_arn",
+				Description: "The Amazon Resource Name that created or last modified the configuration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BlockPublicAccessConfigurationMetadata.CreatedByArn"),
+			},
+			{
+				Name:        "creation_date_time",
+				Description: "The date and time that the configuration was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("BlockPublicAccessConfigurationMetadata.CreationDateTime"),
+			},
+			{
+				Name:        "permitted_public_security_group_rule_ranges",
+				Description: "The ports for which inbound rules from 0.0.0.0/0 and ::/0 are allowed in security groups associated with cluster instances.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("BlockPublicAccessConfiguration.PermittedPublicSecurityGroupRuleRanges"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(getEmrBlockPublicAccessConfigurationTitle),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEmrBlockPublicAccessConfigurations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listEmrBlockPublicAccessConfigurations")
+
+	// Create Session
+	svc, err := EmrService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := svc.GetBlockPublicAccessConfiguration(&emr.GetBlockPublicAccessConfigurationInput{})
+	if err != nil {
+		plugin.Logger(ctx).Error("listEmrBlockPublicAccessConfigurations", "GetBlockPublicAccessConfiguration_error", err)
+		return nil, err
+	}
+
+	d.StreamListItem(ctx, op)
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getEmrBlockPublicAccessConfigurationTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	region := d.MatrixItem[matrixKeyRegion]
+
+	title := region.(string) + " EMR Block Public Access Configuration"
+	return title, nil
+}
@@ -0,0 +1,154 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEmrInstance(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_emr_instance",
+		Description: "AWS EMR Instance",
+		List: &plugin.ListConfig{
+			ParentHydrate: listEmrClusters,
+			Hydrate:       listEmrInstances,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "cluster_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The unique identifier for the instance in Amazon EMR.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cluster_id",
+				Description: "The unique identifier for the cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ClusterID"),
+			},
+			{
+				Name:        "ec2_instance_id",
+				Description: "The unique identifier of the instance in Amazon EC2.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status.State"),
+			},
+			{
+				Name:        "instance_type",
+				Description: "The EC2 instance type, for example m3.xlarge.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "market",
+				Description: "The instance purchasing option, either ON_DEMAND or SPOT.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance_group_id",
+				Description: "The identifier of the instance group to which this instance belongs.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance_fleet_id",
+				Description: "The unique identifier of the instance fleet to which an EC2 instance belongs.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "private_dns_name",
+				Description: "The private DNS name of the instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "private_ip_address",
+				Description: "The private IP address of the instance.",
+				Type:        proto.ColumnType_IPADDR,
+			},
+			{
+				Name:        "public_dns_name",
+				Description: "The public DNS name of the instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "public_ip_address",
+				Description: "The public IP address of the instance.",
+				Type:        proto.ColumnType_IPADDR,
+			},
+			{
+				Name:        "ebs_volumes",
+				Description: "The list of Amazon EBS volumes that are attached to this instance.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the instance, including its state change reason and timeline.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+		}),
+	}
+}
+
+type emrInstanceDetails = struct {
+	emr.Instance
+	ClusterID string
+}
+
+//// LIST FUNCTION
+
+func listEmrInstances(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get cluster details
+	clusterID := h.Item.(*emr.ClusterSummary).Id
+
+	// Minimize the API call with the given cluster id
+	if d.KeyColumnQuals["cluster_id"] != nil && d.KeyColumnQuals["cluster_id"].GetStringValue() != *clusterID {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := EmrService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &emr.ListInstancesInput{
+		ClusterId: clusterID,
+	}
+
+	// List call
+	err = svc.ListInstancesPages(
+		input,
+		func(page *emr.ListInstancesOutput, isLast bool) bool {
+			for _, instance := range page.Instances {
+				d.StreamListItem(ctx, emrInstanceDetails{*instance, *clusterID})
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
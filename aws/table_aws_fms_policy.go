@@ -0,0 +1,273 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsFMSPolicy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_fms_policy",
+		Description: "AWS Firewall Manager Policy",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("policy_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFoundException", "InvalidInputException", "AccessDeniedException"}),
+			},
+			Hydrate: getFMSPolicy,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listFMSPolicies,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "policy_name",
+				Description: "The name of the Firewall Manager policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PolicyName", "Policy.PolicyName"),
+			},
+			{
+				Name:        "policy_id",
+				Description: "The ID of the Firewall Manager policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PolicyId", "Policy.PolicyId"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PolicyArn"),
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of resource protected by or in scope of the policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceType", "Policy.ResourceType"),
+			},
+			{
+				Name:        "security_service_type",
+				Description: "The service that the policy is using to protect the resources, e.g. WAF, WAFV2, SHIELD_ADVANCED, SECURITY_GROUPS_COMMON or NETWORK_FIREWALL.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getFMSPolicy,
+				Transform:   transform.FromField("Policy.SecurityServicePolicyData.Type"),
+			},
+			{
+				Name:        "remediation_enabled",
+				Description: "Indicates if the policy should be automatically applied to new resources.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("RemediationEnabled", "Policy.RemediationEnabled"),
+			},
+			{
+				Name:        "delete_unused_fm_managed_resources",
+				Description: "Indicates whether Firewall Manager should automatically remove protections from resources that leave the policy scope.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("DeleteUnusedFMManagedResources", "Policy.DeleteUnusedFMManagedResources"),
+			},
+			{
+				Name:        "exclude_resource_tags",
+				Description: "If true, resources with the specified resource_tags are excluded from the policy; if false, only resources with those tags are in scope.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getFMSPolicy,
+				Transform:   transform.FromField("Policy.ExcludeResourceTags"),
+			},
+			{
+				Name:        "policy_update_token",
+				Description: "A unique identifier for each update to the policy.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getFMSPolicy,
+				Transform:   transform.FromField("Policy.PolicyUpdateToken"),
+			},
+			{
+				Name:        "exclude_map",
+				Description: "Specifies the Amazon Web Services account IDs and Organizations organizational units (OUs) to exclude from the policy.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getFMSPolicy,
+				Transform:   transform.FromField("Policy.ExcludeMap"),
+			},
+			{
+				Name:        "include_map",
+				Description: "Specifies the Amazon Web Services account IDs and Organizations organizational units (OUs) to include in the policy.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getFMSPolicy,
+				Transform:   transform.FromField("Policy.IncludeMap"),
+			},
+			{
+				Name:        "resource_tags",
+				Description: "An array of resource tags used to include or exclude resources from the policy.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getFMSPolicy,
+				Transform:   transform.FromField("Policy.ResourceTags"),
+			},
+			{
+				Name:        "resource_type_list",
+				Description: "An array of resource types, used when the policy applies to more than one resource type.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getFMSPolicy,
+				Transform:   transform.FromField("Policy.ResourceTypeList"),
+			},
+			{
+				Name:        "security_service_policy_data",
+				Description: "Details about the security service that is being used to protect the resources.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getFMSPolicy,
+				Transform:   transform.FromField("Policy.SecurityServicePolicyData"),
+			},
+			{
+				Name:        "compliance_status",
+				Description: "The compliance status of the policy for each member account.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listFMSPolicyComplianceStatus,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PolicyName", "Policy.PolicyName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PolicyArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listFMSPolicies(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listFMSPolicies")
+
+	// Create session
+	svc, err := FMSService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &fms.ListPoliciesInput{
+		MaxResults: aws.Int64(100),
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxResults {
+			if *limit < 1 {
+				input.MaxResults = aws.Int64(1)
+			} else {
+				input.MaxResults = limit
+			}
+		}
+	}
+
+	// List call
+	err = svc.ListPoliciesPages(
+		input,
+		func(page *fms.ListPoliciesOutput, isLast bool) bool {
+			for _, policy := range page.PolicyList {
+				d.StreamListItem(ctx, policy)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		// The account is not the Firewall Manager administrator or no administrator is set
+		if a, ok := err.(awserr.Error); ok {
+			if a.Code() == "ResourceNotFoundException" || a.Code() == "AccessDeniedException" {
+				return nil, nil
+			}
+		}
+		plugin.Logger(ctx).Error("listFMSPolicies", "ListPoliciesPages_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getFMSPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getFMSPolicy")
+
+	var id string
+	if h.Item != nil {
+		id = *h.Item.(*fms.PolicySummary).PolicyId
+	} else {
+		id = d.KeyColumnQuals["policy_id"].GetStringValue()
+	}
+
+	// Create session
+	svc, err := FMSService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &fms.GetPolicyInput{
+		PolicyId: aws.String(id),
+	}
+
+	op, err := svc.GetPolicy(params)
+	if err != nil {
+		plugin.Logger(ctx).Debug("getFMSPolicy", "ERROR", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func listFMSPolicyComplianceStatus(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listFMSPolicyComplianceStatus")
+
+	var id *string
+	switch item := h.Item.(type) {
+	case *fms.PolicySummary:
+		id = item.PolicyId
+	case *fms.GetPolicyOutput:
+		id = item.Policy.PolicyId
+	}
+
+	// Create session
+	svc, err := FMSService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &fms.ListComplianceStatusInput{
+		PolicyId:   id,
+		MaxResults: aws.Int64(100),
+	}
+
+	var statuses []*fms.PolicyComplianceStatus
+	err = svc.ListComplianceStatusPages(
+		params,
+		func(page *fms.ListComplianceStatusOutput, isLast bool) bool {
+			statuses = append(statuses, page.PolicyComplianceStatusList...)
+			return !isLast
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Debug("listFMSPolicyComplianceStatus", "ERROR", err)
+		return nil, err
+	}
+
+	return statuses, nil
+}
@@ -0,0 +1,267 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsGlobalAcceleratorAccelerator(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_globalaccelerator_accelerator",
+		Description: "AWS Global Accelerator Accelerator",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"AcceleratorNotFoundException", "InvalidArgumentException"}),
+			},
+			Hydrate: getGlobalAcceleratorAccelerator,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listGlobalAcceleratorAccelerators,
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the accelerator.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the accelerator.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AcceleratorArn"),
+			},
+			{
+				Name:        "status",
+				Description: "Describes the deployment status of the accelerator, either DEPLOYED or IN_PROGRESS.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "enabled",
+				Description: "Indicates whether the accelerator is enabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "dns_name",
+				Description: "The Domain Name System (DNS) name that Global Accelerator creates that points to the static IP addresses of the accelerator.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ip_address_type",
+				Description: "The IP address type of the accelerator.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ip_sets",
+				Description: "The static IP addresses that Global Accelerator associates with the accelerator.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "created_time",
+				Description: "The date and time that the accelerator was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "The date and time that the accelerator was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "flow_logs_enabled",
+				Description: "Indicates whether flow logs are enabled for the accelerator.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getGlobalAcceleratorAcceleratorAttributes,
+			},
+			{
+				Name:        "flow_logs_s3_bucket",
+				Description: "The name of the Amazon S3 bucket for the flow logs.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getGlobalAcceleratorAcceleratorAttributes,
+			},
+			{
+				Name:        "flow_logs_s3_prefix",
+				Description: "The prefix for the location in the Amazon S3 bucket for the flow logs.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getGlobalAcceleratorAcceleratorAttributes,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags attached to the accelerator.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getGlobalAcceleratorAcceleratorTags,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getGlobalAcceleratorAcceleratorTags,
+				Transform:   transform.FromValue().Transform(globalAcceleratorTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AcceleratorArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGlobalAcceleratorAccelerators(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listGlobalAcceleratorAccelerators")
+
+	// Create session
+	svc, err := GlobalAcceleratorService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &globalaccelerator.ListAcceleratorsInput{
+		MaxResults: aws.Int64(100),
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxResults {
+			if *limit < 1 {
+				input.MaxResults = aws.Int64(1)
+			} else {
+				input.MaxResults = limit
+			}
+		}
+	}
+
+	// List call
+	err = svc.ListAcceleratorsPages(
+		input,
+		func(page *globalaccelerator.ListAcceleratorsOutput, isLast bool) bool {
+			for _, accelerator := range page.Accelerators {
+				d.StreamListItem(ctx, accelerator)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("listGlobalAcceleratorAccelerators", "ListAcceleratorsPages_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getGlobalAcceleratorAccelerator(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getGlobalAcceleratorAccelerator")
+
+	arn := d.KeyColumnQuals["arn"].GetStringValue()
+
+	// Empty check
+	if arn == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := GlobalAcceleratorService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := svc.DescribeAccelerator(&globalaccelerator.DescribeAcceleratorInput{
+		AcceleratorArn: aws.String(arn),
+	})
+	if err != nil {
+		plugin.Logger(ctx).Debug("getGlobalAcceleratorAccelerator", "ERROR", err)
+		return nil, err
+	}
+
+	return op.Accelerator, nil
+}
+
+func getGlobalAcceleratorAcceleratorAttributes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getGlobalAcceleratorAcceleratorAttributes")
+	accelerator := h.Item.(*globalaccelerator.Accelerator)
+
+	// Create session
+	svc, err := GlobalAcceleratorService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := svc.DescribeAcceleratorAttributes(&globalaccelerator.DescribeAcceleratorAttributesInput{
+		AcceleratorArn: accelerator.AcceleratorArn,
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("getGlobalAcceleratorAcceleratorAttributes", "DescribeAcceleratorAttributes_error", err)
+		return nil, err
+	}
+
+	return op.AcceleratorAttributes, nil
+}
+
+func getGlobalAcceleratorAcceleratorTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getGlobalAcceleratorAcceleratorTags")
+	accelerator := h.Item.(*globalaccelerator.Accelerator)
+
+	// Create session
+	svc, err := GlobalAcceleratorService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := svc.ListTagsForResource(&globalaccelerator.ListTagsForResourceInput{
+		ResourceArn: accelerator.AcceleratorArn,
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("getGlobalAcceleratorAcceleratorTags", "ListTagsForResource_error", err)
+		return nil, err
+	}
+
+	return op.Tags, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func globalAcceleratorTagListToTurbotTags(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("globalAcceleratorTagListToTurbotTags")
+	tags, ok := d.Value.([]*globalaccelerator.Tag)
+	if !ok {
+		return nil, nil
+	}
+
+	// Mapping the resource tags inside turbotTags
+	var turbotTagsMap map[string]string
+	if tags != nil {
+		turbotTagsMap = map[string]string{}
+		for _, i := range tags {
+			turbotTagsMap[*i.Key] = *i.Value
+		}
+	}
+
+	return turbotTagsMap, nil
+}
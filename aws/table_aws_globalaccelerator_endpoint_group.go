@@ -0,0 +1,184 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+type globalAcceleratorEndpointGroupInfo struct {
+	*globalaccelerator.EndpointGroup
+	AcceleratorArn *string
+	ListenerArn    *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsGlobalAcceleratorEndpointGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_globalaccelerator_endpoint_group",
+		Description: "AWS Global Accelerator Endpoint Group",
+		List: &plugin.ListConfig{
+			ParentHydrate: listGlobalAcceleratorAccelerators,
+			Hydrate:       listGlobalAcceleratorEndpointGroups,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "accelerator_arn", Require: plugin.Optional},
+				{Name: "listener_arn", Require: plugin.Optional},
+			},
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the endpoint group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EndpointGroupArn"),
+			},
+			{
+				Name:        "accelerator_arn",
+				Description: "The Amazon Resource Name (ARN) of the accelerator the endpoint group belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "listener_arn",
+				Description: "The Amazon Resource Name (ARN) of the listener the endpoint group belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "endpoint_group_region",
+				Description: "The AWS Region where the endpoint group is located.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "traffic_dial_percentage",
+				Description: "The percentage of traffic to send to the AWS Region of the endpoint group.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "health_check_protocol",
+				Description: "The protocol that Global Accelerator uses to perform health checks on the endpoints, either TCP, HTTP or HTTPS.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "health_check_port",
+				Description: "The port that Global Accelerator uses to perform health checks on the endpoints.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "health_check_path",
+				Description: "The path to use for HTTP and HTTPS health checks.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "health_check_interval_seconds",
+				Description: "The time, 10 or 30 seconds, between health checks for each endpoint.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "threshold_count",
+				Description: "The number of consecutive health checks required to set the state of a healthy endpoint to unhealthy, or an unhealthy endpoint to healthy.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "endpoint_descriptions",
+				Description: "The endpoints of the endpoint group, with their weights and health state.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "port_overrides",
+				Description: "The port overrides that map the listener ports to the endpoint ports.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EndpointGroupArn"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("EndpointGroupArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGlobalAcceleratorEndpointGroups(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listGlobalAcceleratorEndpointGroups")
+
+	accelerator := h.Item.(*globalaccelerator.Accelerator)
+
+	// Minimize the API call with the given accelerator arn
+	if d.KeyColumnQuals["accelerator_arn"] != nil && d.KeyColumnQuals["accelerator_arn"].GetStringValue() != *accelerator.AcceleratorArn {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := GlobalAcceleratorService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Endpoint groups belong to the listeners of the accelerator
+	var listenerArns []*string
+	err = svc.ListListenersPages(
+		&globalaccelerator.ListListenersInput{
+			AcceleratorArn: accelerator.AcceleratorArn,
+			MaxResults:     aws.Int64(100),
+		},
+		func(page *globalaccelerator.ListListenersOutput, isLast bool) bool {
+			for _, listener := range page.Listeners {
+				if d.KeyColumnQuals["listener_arn"] != nil && d.KeyColumnQuals["listener_arn"].GetStringValue() != *listener.ListenerArn {
+					continue
+				}
+				listenerArns = append(listenerArns, listener.ListenerArn)
+			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("listGlobalAcceleratorEndpointGroups", "ListListenersPages_error", err)
+		return nil, err
+	}
+
+	for _, listenerArn := range listenerArns {
+		var stop bool
+		err = svc.ListEndpointGroupsPages(
+			&globalaccelerator.ListEndpointGroupsInput{
+				ListenerArn: listenerArn,
+				MaxResults:  aws.Int64(100),
+			},
+			func(page *globalaccelerator.ListEndpointGroupsOutput, isLast bool) bool {
+				for _, endpointGroup := range page.EndpointGroups {
+					d.StreamListItem(ctx, &globalAcceleratorEndpointGroupInfo{endpointGroup, accelerator.AcceleratorArn, listenerArn})
+
+					// Context may get cancelled due to manual cancellation or if the limit has been reached
+					if d.QueryStatus.RowsRemaining(ctx) == 0 {
+						stop = true
+						return false
+					}
+				}
+				return !isLast
+			},
+		)
+		if err != nil {
+			plugin.Logger(ctx).Error("listGlobalAcceleratorEndpointGroups", "ListEndpointGroupsPages_error", err)
+			return nil, err
+		}
+		if stop {
+			break
+		}
+	}
+
+	return nil, nil
+}
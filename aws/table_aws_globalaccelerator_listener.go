@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+type globalAcceleratorListenerInfo struct {
+	*globalaccelerator.Listener
+	AcceleratorArn *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsGlobalAcceleratorListener(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_globalaccelerator_listener",
+		Description: "AWS Global Accelerator Listener",
+		List: &plugin.ListConfig{
+			ParentHydrate: listGlobalAcceleratorAccelerators,
+			Hydrate:       listGlobalAcceleratorListeners,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "accelerator_arn", Require: plugin.Optional},
+			},
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the listener.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ListenerArn"),
+			},
+			{
+				Name:        "accelerator_arn",
+				Description: "The Amazon Resource Name (ARN) of the accelerator the listener belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "protocol",
+				Description: "The protocol for the connections from clients to the accelerator, either TCP or UDP.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "client_affinity",
+				Description: "The client affinity of the listener, either NONE or SOURCE_IP.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "port_ranges",
+				Description: "The list of port ranges for the connections from clients to the accelerator.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ListenerArn"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ListenerArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGlobalAcceleratorListeners(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listGlobalAcceleratorListeners")
+
+	accelerator := h.Item.(*globalaccelerator.Accelerator)
+
+	// Minimize the API call with the given accelerator arn
+	if d.KeyColumnQuals["accelerator_arn"] != nil && d.KeyColumnQuals["accelerator_arn"].GetStringValue() != *accelerator.AcceleratorArn {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := GlobalAcceleratorService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &globalaccelerator.ListListenersInput{
+		AcceleratorArn: accelerator.AcceleratorArn,
+		MaxResults:     aws.Int64(100),
+	}
+
+	// List call
+	err = svc.ListListenersPages(
+		input,
+		func(page *globalaccelerator.ListListenersOutput, isLast bool) bool {
+			for _, listener := range page.Listeners {
+				d.StreamListItem(ctx, &globalAcceleratorListenerInfo{listener, accelerator.AcceleratorArn})
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("listGlobalAcceleratorListeners", "ListListenersPages_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
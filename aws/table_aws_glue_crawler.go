@@ -51,6 +51,18 @@ func tableAwsGlueCrawler(_ context.Context) *plugin.Table {
 				Description: "Indicates whether the crawler is running or pending.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "last_crawl_status",
+				Description: "The status of the last crawl, either SUCCEEDED, CANCELLED or FAILED.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LastCrawl.Status"),
+			},
+			{
+				Name:        "last_crawl_error_message",
+				Description: "If an error occurred during the last crawl, the error information about it.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LastCrawl.ErrorMessage"),
+			},
 			{
 				Name:        "role",
 				Description: "The Amazon Resource Name (ARN) of an IAM role that's used to access customer resources, such as Amazon Simple Storage Service (Amazon S3) data.",
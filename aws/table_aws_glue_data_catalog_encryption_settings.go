@@ -0,0 +1,103 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/glue"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsGlueDataCatalogEncryptionSettings(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_glue_data_catalog_encryption_settings",
+		Description: "AWS Glue Data Catalog Encryption Settings",
+		List: &plugin.ListConfig{
+			Hydrate: listGlueDataCatalogEncryptionSettings,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "catalog_encryption_mode",
+				Description: "The encryption-at-rest mode for encrypting Data Catalog data, either DISABLED or SSE-KMS.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EncryptionAtRest.CatalogEncryptionMode"),
+			},
+			{
+				Name:        "sse_aws_kms_key_id",
+				Description: "The ID of the KMS key to use for encryption at rest.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EncryptionAtRest.SseAwsKmsKeyId"),
+			},
+			{
+				Name:        "return_connection_password_encrypted",
+				Description: "When set to true, passwords remain encrypted in the responses of GetConnection and GetConnections.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("ConnectionPasswordEncryption.ReturnConnectionPasswordEncrypted"),
+			},
+			{
+				Name:        "connection_password_aws_kms_key_id",
+				Description: "The ID of the KMS key used to encrypt the connection passwords.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConnectionPasswordEncryption.AwsKmsKeyId"),
+			},
+			{
+				Name:        "encryption_at_rest",
+				Description: "Specifies the encryption-at-rest configuration for the Data Catalog.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "connection_password_encryption",
+				Description: "When connection password protection is enabled, the Data Catalog uses a customer-provided key to encrypt the password as part of CreateConnection or UpdateConnection and store it in the ENCRYPTED_PASSWORD field in the connection properties.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(getGlueDataCatalogEncryptionSettingsTitle),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGlueDataCatalogEncryptionSettings(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listGlueDataCatalogEncryptionSettings")
+
+	// Create session
+	svc, err := GlueService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// The settings of the account's own Data Catalog are returned when no
+	// catalog ID is passed
+	op, err := svc.GetDataCatalogEncryptionSettings(&glue.GetDataCatalogEncryptionSettingsInput{})
+	if err != nil {
+		plugin.Logger(ctx).Error("listGlueDataCatalogEncryptionSettings", "GetDataCatalogEncryptionSettings_error", err)
+		return nil, err
+	}
+
+	if op.DataCatalogEncryptionSettings != nil {
+		d.StreamListItem(ctx, op.DataCatalogEncryptionSettings)
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getGlueDataCatalogEncryptionSettingsTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	region := d.MatrixItem[matrixKeyRegion]
+
+	title := region.(string) + " Glue Data Catalog Encryption Settings"
+	return title, nil
+}
@@ -0,0 +1,326 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsGlueJob(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_glue_job",
+		Description: "AWS Glue Job",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"EntityNotFoundException"}),
+			},
+			Hydrate: getGlueJob,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listGlueJobs,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name you assign to this job definition.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the job.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getGlueJobArn,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "description",
+				Description: "A description of the job.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "role",
+				Description: "The name or Amazon Resource Name (ARN) of the IAM role associated with this job.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_on",
+				Description: "The time and date that this job definition was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_modified_on",
+				Description: "The last point in time when this job definition was modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "glue_version",
+				Description: "Glue version determines the versions of Apache Spark and Python that Glue supports.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "script_location",
+				Description: "Specifies the Amazon Simple Storage Service (Amazon S3) path to a script that runs a job.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Command.ScriptLocation"),
+			},
+			{
+				Name:        "security_configuration",
+				Description: "The name of the SecurityConfiguration structure to be used with this job.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "max_capacity",
+				Description: "The number of Glue data processing units (DPUs) that can be allocated when this job runs.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "max_retries",
+				Description: "The maximum number of times to retry this job after a JobRun fails.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "number_of_workers",
+				Description: "The number of workers of a defined workerType that are allocated when a job runs.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "timeout",
+				Description: "The job timeout in minutes.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "worker_type",
+				Description: "The type of predefined worker that is allocated when a job runs, either Standard, G.1X or G.2X.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "log_uri",
+				Description: "This field is reserved for future use.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "command",
+				Description: "The JobCommand that runs this job.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "connections",
+				Description: "The connections used for this job.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Connections.Connections"),
+			},
+			{
+				Name:        "default_arguments",
+				Description: "The default arguments for this job, specified as name-value pairs.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "non_overridable_arguments",
+				Description: "Non-overridable arguments for this job, specified as name-value pairs.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "execution_property",
+				Description: "An ExecutionProperty specifying the maximum number of concurrent runs allowed for this job.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "notification_property",
+				Description: "Specifies configuration properties of a job notification.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "last_run",
+				Description: "The most recent run of the job, including its state, error message and execution time.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getGlueJobLastRun,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getGlueJobTags,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getGlueJobArn,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGlueJobs(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := GlueService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_glue_job.listGlueJobs", "service_creation_error", err)
+		return nil, err
+	}
+
+	input := &glue.GetJobsInput{
+		MaxResults: aws.Int64(100),
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxResults {
+			if *limit < 1 {
+				input.MaxResults = aws.Int64(1)
+			} else {
+				input.MaxResults = limit
+			}
+		}
+	}
+
+	// List call
+	err = svc.GetJobsPages(
+		input,
+		func(page *glue.GetJobsOutput, isLast bool) bool {
+			for _, job := range page.Jobs {
+				d.StreamListItem(ctx, job)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_glue_job.listGlueJobs", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getGlueJob(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// check if name is empty
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := GlueService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_glue_job.getGlueJob", "service_creation_error", err)
+		return nil, err
+	}
+
+	// Build the params
+	params := &glue.GetJobInput{
+		JobName: aws.String(name),
+	}
+
+	// Get call
+	data, err := svc.GetJob(params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_glue_job.getGlueJob", "api_error", err)
+		return nil, err
+	}
+	return data.Job, nil
+}
+
+func getGlueJobLastRun(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	data := h.Item.(*glue.Job)
+
+	// Create Session
+	svc, err := GlueService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_glue_job.getGlueJobLastRun", "service_creation_error", err)
+		return nil, err
+	}
+
+	// Job runs are returned most recent first
+	params := &glue.GetJobRunsInput{
+		JobName:    data.Name,
+		MaxResults: aws.Int64(1),
+	}
+
+	op, err := svc.GetJobRuns(params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_glue_job.getGlueJobLastRun", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.JobRuns) > 0 {
+		return op.JobRuns[0], nil
+	}
+	return nil, nil
+}
+
+func getGlueJobTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	arn, err := getGlueJobArn(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create Session
+	svc, err := GlueService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_glue_job.getGlueJobTags", "service_creation_error", err)
+		return nil, err
+	}
+
+	params := &glue.GetTagsInput{
+		ResourceArn: aws.String(arn.(string)),
+	}
+
+	op, err := svc.GetTags(params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_glue_job.getGlueJobTags", "api_error", err)
+		return nil, err
+	}
+
+	return op.Tags, nil
+}
+
+func getGlueJobArn(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	data := h.Item.(*glue.Job)
+
+	// Get common columns
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	c, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := c.(*awsCommonColumnData)
+
+	// arn format - https://docs.aws.amazon.com/glue/latest/dg/glue-specifying-resource-arns.html
+	// arn:aws:glue:region:account-id:job/job-name
+	arn := "arn:" + commonColumnData.Partition + ":glue:" + region + ":" + commonColumnData.AccountId + ":job/" + *data.Name
+
+	return arn, nil
+}
@@ -0,0 +1,104 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsGuardDutyOrganizationConfiguration(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_guardduty_organization_configuration",
+		Description: "AWS GuardDuty Organization Configuration",
+		List: &plugin.ListConfig{
+			ParentHydrate: listGuardDutyDetectors,
+			Hydrate:       listGuardDutyOrganizationConfigurations,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "detector_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "detector_id",
+				Description: "The ID of the detector of the GuardDuty delegated administrator account.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "auto_enable",
+				Description: "Indicates whether GuardDuty is automatically enabled for the accounts that join the organization.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "member_account_limit_reached",
+				Description: "Indicates whether the maximum number of allowed member accounts are already associated with the delegated administrator account.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "s3_logs_auto_enable",
+				Description: "Indicates whether S3 data event logs are automatically enabled as a data source for the new members of the organization.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("DataSources.S3Logs.AutoEnable"),
+			},
+			{
+				Name:        "data_sources",
+				Description: "The data sources that are automatically enabled for the new members of the organization.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DetectorId"),
+			},
+		}),
+	}
+}
+
+type guardDutyOrganizationConfigurationInfo = struct {
+	guardduty.DescribeOrganizationConfigurationOutput
+	DetectorId string
+}
+
+//// LIST FUNCTION
+
+func listGuardDutyOrganizationConfigurations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listGuardDutyOrganizationConfigurations")
+	detectorId := h.Item.(detectorInfo).DetectorID
+
+	// Minimize the API call with the given detector_id
+	if d.KeyColumnQuals["detector_id"] != nil && d.KeyColumnQuals["detector_id"].GetStringValue() != detectorId {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := GuardDutyService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := svc.DescribeOrganizationConfiguration(&guardduty.DescribeOrganizationConfigurationInput{
+		DetectorId: aws.String(detectorId),
+	})
+	if err != nil {
+		// The organization configuration is only available to the delegated administrator account
+		if a, ok := err.(awserr.Error); ok && a.Code() == "BadRequestException" {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("listGuardDutyOrganizationConfigurations", "DescribeOrganizationConfiguration_error", err)
+		return nil, err
+	}
+
+	d.StreamListItem(ctx, guardDutyOrganizationConfigurationInfo{*op, detectorId})
+
+	return nil, nil
+}
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"time"
 
@@ -15,6 +16,10 @@ import (
 const maxRetries = 20
 const retryIntervalMs = 500
 
+// Generated reports can be retrieved for a while after the job completes, so
+// the job is reused for repeated queries on the same principal
+const accessAdvisorJobCacheTTL = 30 * time.Minute
+
 type awsIamAccessAdvisorData struct {
 	PrincipalArn               string
 	Granularity                string
@@ -116,14 +121,22 @@ func listAccessAdvisor(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 	}
 
 	// Generate the details.  We'll need the job id of this to get the details...
-	generateResp, err := svc.GenerateServiceLastAccessedDetails(&iam.GenerateServiceLastAccessedDetailsInput{Arn: &principalArn, Granularity: &granularity})
-	if err != nil {
-		return nil, err
+	jobCacheKey := fmt.Sprintf("iam-access-advisor-job-%s", principalArn)
+	var jobId *string
+	if cachedData, ok := d.ConnectionManager.Cache.Get(jobCacheKey); ok {
+		jobId = cachedData.(*string)
+	} else {
+		generateResp, err := svc.GenerateServiceLastAccessedDetails(&iam.GenerateServiceLastAccessedDetailsInput{Arn: &principalArn, Granularity: &granularity})
+		if err != nil {
+			return nil, err
+		}
+		logger.Debug("listAccessAdvisor generateResp", "jobId", *generateResp.JobId, "resp", *generateResp)
+		jobId = generateResp.JobId
+		d.ConnectionManager.Cache.SetWithTTL(jobCacheKey, jobId, accessAdvisorJobCacheTTL)
 	}
-	logger.Debug("listAccessAdvisor generateResp", "jobId", *generateResp.JobId, "resp", *generateResp)
 
 	params := &iam.GetServiceLastAccessedDetailsInput{
-		JobId:    generateResp.JobId,
+		JobId:    jobId,
 		MaxItems: aws.Int64(1000),
 	}
 
@@ -143,16 +156,34 @@ func listAccessAdvisor(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 	for {
 		resp, err := svc.GetServiceLastAccessedDetails(params)
 		if err != nil {
+			// The cached job may have expired, so generate a new one next time
+			d.ConnectionManager.Cache.Delete(jobCacheKey)
 			return nil, err
 		}
-		logger.Debug("listAccessAdvisor Details", "jobId", *generateResp.JobId, "status", *resp.JobStatus, "resp", *resp)
-
-		// if job is still in progress, wait and retry
-		if *resp.JobStatus == "IN_PROGRESS" && retryNumber < maxRetries {
+		logger.Debug("listAccessAdvisor Details", "jobId", *jobId, "status", *resp.JobStatus, "resp", *resp)
+
+		switch *resp.JobStatus {
+		case iam.JobStatusTypeInProgress:
+			// if job is still in progress, wait and retry
+			if retryNumber >= maxRetries {
+				d.ConnectionManager.Cache.Delete(jobCacheKey)
+				return nil, fmt.Errorf("timed out waiting for service last accessed details job %s for %s", *jobId, principalArn)
+			}
 			retryNumber++
 			logger.Debug("GetServiceLastAccessedDetails in progress", "retryNumber", retryNumber)
-			time.Sleep(retryIntervalMs * time.Millisecond)
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(retryIntervalMs * time.Millisecond):
+			}
 			continue
+		case iam.JobStatusTypeFailed:
+			d.ConnectionManager.Cache.Delete(jobCacheKey)
+			message := "unknown error"
+			if resp.Error != nil && resp.Error.Message != nil {
+				message = *resp.Error.Message
+			}
+			return nil, fmt.Errorf("service last accessed details job %s for %s failed: %s", *jobId, principalArn, message)
 		}
 
 		// Stream results
@@ -171,7 +202,7 @@ func listAccessAdvisor(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 
 			// Context may get cancelled due to manual cancellation or if the limit has been reached
 			if d.QueryStatus.RowsRemaining(ctx) == 0 {
-				break
+				return nil, nil
 			}
 		}
 		if !*resp.IsTruncated {
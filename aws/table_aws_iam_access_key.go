@@ -2,14 +2,13 @@ package aws
 
 import (
 	"context"
-	"fmt"
-	"strings"
 
 	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/iam"
 )
 
@@ -18,11 +17,13 @@ func tableAwsIamAccessKey(_ context.Context) *plugin.Table {
 		Name:        "aws_iam_access_key",
 		Description: "AWS IAM User Access Key",
 		List: &plugin.ListConfig{
-			ParentHydrate: listIamUsers,
-			Hydrate:       listUserAccessKeys,
+			Hydrate: listIamAccessKeys,
 			KeyColumns: []*plugin.KeyColumn{
 				{Name: "user_name", Require: plugin.Optional},
 			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"NoSuchEntity"}),
+			},
 		},
 		Columns: awsColumns([]*plugin.Column{
 			{
@@ -45,6 +46,27 @@ func tableAwsIamAccessKey(_ context.Context) *plugin.Table {
 				Description: "The date when the access key was created.",
 				Type:        proto.ColumnType_TIMESTAMP,
 			},
+			{
+				Name:        "access_key_last_used_date",
+				Description: "The date and time when the access key was most recently used. Null if the access key has never been used.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getIamAccessKeyLastUsed,
+				Transform:   transform.FromField("LastUsedDate"),
+			},
+			{
+				Name:        "last_used_service",
+				Description: "The name of the AWS service with which the access key was most recently used, or N/A if the access key has never been used.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getIamAccessKeyLastUsed,
+				Transform:   transform.FromField("ServiceName"),
+			},
+			{
+				Name:        "last_used_region",
+				Description: "The AWS region where the access key was most recently used, or N/A if the access key has never been used.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getIamAccessKeyLastUsed,
+				Transform:   transform.FromField("Region"),
+			},
 			{
 				Name:        "title",
 				Description: resourceInterfaceDescription("title"),
@@ -64,32 +86,78 @@ func tableAwsIamAccessKey(_ context.Context) *plugin.Table {
 
 //// LIST FUNCTION
 
-func listUserAccessKeys(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("listUserAccessKeys")
-	user := h.Item.(*iam.User)
+func listIamAccessKeys(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listIamAccessKeys")
+
+	// Create Session
+	svc, err := IAMService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
 
-	// Minimize the API call with the given user_name
+	// Minimize the API calls with the given user_name, only listing the access
+	// keys of the requested users instead of every user in the account
 	equalQuals := d.KeyColumnQuals
 	if equalQuals["user_name"] != nil {
+		var userNames []string
 		if equalQuals["user_name"].GetStringValue() != "" {
-			if equalQuals["user_name"].GetStringValue() != "" && equalQuals["user_name"].GetStringValue() != *user.UserName {
-				return nil, nil
+			userNames = []string{equalQuals["user_name"].GetStringValue()}
+		} else {
+			for _, name := range getListValues(equalQuals["user_name"].GetListValue()) {
+				userNames = append(userNames, *name)
+			}
+		}
+
+		for _, userName := range userNames {
+			if err := listUserAccessKeys(ctx, d, svc, userName); err != nil {
+				// Skip user names that do not exist
+				if a, ok := err.(awserr.Error); ok && a.Code() == "NoSuchEntity" {
+					continue
+				}
+				return nil, err
 			}
-		} else if len(getListValues(equalQuals["user_name"].GetListValue())) > 0 {
-			if !strings.Contains(fmt.Sprint(getListValues(equalQuals["user_name"].GetListValue())), *user.UserName) {
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
 				return nil, nil
 			}
 		}
+		return nil, nil
 	}
 
-	// Create Session
-	svc, err := IAMService(ctx, d)
+	input := &iam.ListUsersInput{
+		MaxItems: aws.Int64(1000),
+	}
+
+	var listErr error
+	err = svc.ListUsersPages(
+		input,
+		func(page *iam.ListUsersOutput, lastPage bool) bool {
+			for _, user := range page.Users {
+				listErr = listUserAccessKeys(ctx, d, svc, *user.UserName)
+				if listErr != nil {
+					return false
+				}
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !lastPage
+		},
+	)
 	if err != nil {
+		plugin.Logger(ctx).Error("listIamAccessKeys", "ListUsersPages", err)
 		return nil, err
 	}
 
+	return nil, listErr
+}
+
+func listUserAccessKeys(ctx context.Context, d *plugin.QueryData, svc *iam.IAM, userName string) error {
 	params := &iam.ListAccessKeysInput{
-		UserName: user.UserName,
+		UserName: aws.String(userName),
 		MaxItems: aws.Int64(1000),
 	}
 
@@ -106,7 +174,7 @@ func listUserAccessKeys(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 	}
 
 	// List IAM user access keys
-	err = svc.ListAccessKeysPages(
+	err := svc.ListAccessKeysPages(
 		params,
 		func(page *iam.ListAccessKeysOutput, isLast bool) bool {
 			for _, key := range page.AccessKeyMetadata {
@@ -124,11 +192,34 @@ func listUserAccessKeys(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 		plugin.Logger(ctx).Error("listUserAccessKeys", "ListAccessKeysPages", err)
 	}
 
-	return nil, err
+	return err
 }
 
 //// HYDRATE FUNCTIONS
 
+func getIamAccessKeyLastUsed(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getIamAccessKeyLastUsed")
+	accessKey := h.Item.(*iam.AccessKeyMetadata)
+
+	// Create Session
+	svc, err := IAMService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &iam.GetAccessKeyLastUsedInput{
+		AccessKeyId: accessKey.AccessKeyId,
+	}
+
+	op, err := svc.GetAccessKeyLastUsed(params)
+	if err != nil {
+		plugin.Logger(ctx).Error("getIamAccessKeyLastUsed", "GetAccessKeyLastUsed", err)
+		return nil, err
+	}
+
+	return op.AccessKeyLastUsed, nil
+}
+
 func getIamAccessKeyAka(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	accessKey := h.Item.(*iam.AccessKeyMetadata)
 
@@ -104,7 +104,11 @@ func listAccountPasswordPolicies(ctx context.Context, d *plugin.QueryData, _ *pl
 	resp, err := svc.GetAccountPasswordPolicy(&iam.GetAccountPasswordPolicyInput{})
 	if err != nil {
 		if a, ok := err.(awserr.Error); ok {
+			// No password policy is set for the account, so return a single row
+			// with no settings, which keeps CIS checks against the table failing
+			// rather than returning no results
 			if a.Code() == "NoSuchEntity" {
+				d.StreamListItem(ctx, &iam.PasswordPolicy{})
 				return nil, nil
 			}
 		}
@@ -262,9 +262,12 @@ func listAwsIamGroupInlinePolicies(ctx context.Context, d *plugin.QueryData, h *
 	var wg sync.WaitGroup
 	policyCh := make(chan map[string]interface{}, len(groupData.PolicyNames))
 	errorCh := make(chan error, len(groupData.PolicyNames))
+	// Bound the number of inline policies fetched concurrently
+	sem := make(chan struct{}, maxInlinePolicyConcurrency)
 	for _, policy := range groupData.PolicyNames {
 		wg.Add(1)
-		go getGroupPolicyDataAsync(policy, group.GroupName, svc, &wg, policyCh, errorCh)
+		sem <- struct{}{}
+		go getGroupPolicyDataAsync(policy, group.GroupName, svc, &wg, sem, policyCh, errorCh)
 	}
 
 	// wait for all inline policies to be processed
@@ -288,8 +291,9 @@ func listAwsIamGroupInlinePolicies(ctx context.Context, d *plugin.QueryData, h *
 	return groupPolicies, nil
 }
 
-func getGroupPolicyDataAsync(policy *string, groupName *string, svc *iam.IAM, wg *sync.WaitGroup, policyCh chan map[string]interface{}, errorCh chan error) {
+func getGroupPolicyDataAsync(policy *string, groupName *string, svc *iam.IAM, wg *sync.WaitGroup, sem chan struct{}, policyCh chan map[string]interface{}, errorCh chan error) {
 	defer wg.Done()
+	defer func() { <-sem }()
 
 	rowData, err := getGroupInlinePolicy(policy, groupName, svc)
 	if err != nil {
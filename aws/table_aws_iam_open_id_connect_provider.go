@@ -0,0 +1,188 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/turbot/go-kit/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsIamOpenIdConnectProvider(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_iam_open_id_connect_provider",
+		Description: "AWS IAM OpenID Connect Provider",
+		List: &plugin.ListConfig{
+			Hydrate: listIamOpenIdConnectProviders,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"NoSuchEntity", "InvalidInput"}),
+			},
+			Hydrate: getIamOpenIdConnectProvider,
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the OpenID Connect provider.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "url",
+				Description: "The URL that the IAM OIDC provider resource object is associated with.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getIamOpenIdConnectProviderDetails,
+			},
+			{
+				Name:        "create_date",
+				Description: "The date and time when the IAM OIDC provider resource object was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getIamOpenIdConnectProviderDetails,
+			},
+			{
+				Name:        "client_id_list",
+				Description: "A list of client IDs (also known as audiences) that are associated with the specified IAM OIDC provider resource object.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getIamOpenIdConnectProviderDetails,
+				Transform:   transform.FromField("ClientIDList"),
+			},
+			{
+				Name:        "thumbprint_list",
+				Description: "A list of certificate thumbprints that are associated with the specified IAM OIDC provider resource object.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getIamOpenIdConnectProviderDetails,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags that are attached to the OpenID Connect provider.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getIamOpenIdConnectProviderDetails,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getIamOpenIdConnectProviderDetails,
+				Transform:   transform.FromField("Tags").Transform(iamTagsToTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Arn").Transform(openIdConnectProviderArnToTitle),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listIamOpenIdConnectProviders(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listIamOpenIdConnectProviders")
+
+	// Create Session
+	svc, err := IAMService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// The API does not support pagination
+	op, err := svc.ListOpenIDConnectProviders(&iam.ListOpenIDConnectProvidersInput{})
+	if err != nil {
+		plugin.Logger(ctx).Error("listIamOpenIdConnectProviders", "ListOpenIDConnectProviders", err)
+		return nil, err
+	}
+
+	for _, provider := range op.OpenIDConnectProviderList {
+		d.StreamListItem(ctx, provider)
+
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getIamOpenIdConnectProvider(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getIamOpenIdConnectProvider")
+
+	arn := d.KeyColumnQuals["arn"].GetStringValue()
+
+	// Empty check
+	if arn == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := IAMService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the provider exists, the details are fetched in a separate hydrate call
+	_, err = svc.GetOpenIDConnectProvider(&iam.GetOpenIDConnectProviderInput{
+		OpenIDConnectProviderArn: aws.String(arn),
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("getIamOpenIdConnectProvider", "GetOpenIDConnectProvider", err)
+		return nil, err
+	}
+
+	return &iam.OpenIDConnectProviderListEntry{
+		Arn: aws.String(arn),
+	}, nil
+}
+
+func getIamOpenIdConnectProviderDetails(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getIamOpenIdConnectProviderDetails")
+	provider := h.Item.(*iam.OpenIDConnectProviderListEntry)
+
+	// Create Session
+	svc, err := IAMService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := svc.GetOpenIDConnectProvider(&iam.GetOpenIDConnectProviderInput{
+		OpenIDConnectProviderArn: provider.Arn,
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("getIamOpenIdConnectProviderDetails", "GetOpenIDConnectProvider", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The provider ARN ends with the provider URL without the scheme, e.g.
+// arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-east-1.amazonaws.com/id/EXAMPLE
+func openIdConnectProviderArnToTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	arn := types.SafeString(d.Value)
+	if i := strings.Index(arn, "oidc-provider/"); i >= 0 {
+		return arn[i+len("oidc-provider/"):], nil
+	}
+	return arn, nil
+}
@@ -347,9 +347,12 @@ func listAwsIamRoleInlinePolicies(ctx context.Context, d *plugin.QueryData, h *p
 	var wg sync.WaitGroup
 	policyCh := make(chan map[string]interface{}, len(roleData.PolicyNames))
 	errorCh := make(chan error, len(roleData.PolicyNames))
+	// Bound the number of inline policies fetched concurrently
+	sem := make(chan struct{}, maxInlinePolicyConcurrency)
 	for _, policy := range roleData.PolicyNames {
 		wg.Add(1)
-		go getRolePolicyDataAsync(policy, role.RoleName, svc, &wg, policyCh, errorCh)
+		sem <- struct{}{}
+		go getRolePolicyDataAsync(policy, role.RoleName, svc, &wg, sem, policyCh, errorCh)
 	}
 
 	// wait for all inline policies to be processed
@@ -372,8 +375,9 @@ func listAwsIamRoleInlinePolicies(ctx context.Context, d *plugin.QueryData, h *p
 	return rolePolicies, nil
 }
 
-func getRolePolicyDataAsync(policy *string, roleName *string, svc *iam.IAM, wg *sync.WaitGroup, policyCh chan map[string]interface{}, errorCh chan error) {
+func getRolePolicyDataAsync(policy *string, roleName *string, svc *iam.IAM, wg *sync.WaitGroup, sem chan struct{}, policyCh chan map[string]interface{}, errorCh chan error) {
 	defer wg.Done()
+	defer func() { <-sem }()
 
 	rowData, err := getRoleInlinePolicy(policy, roleName, svc)
 	if err != nil {
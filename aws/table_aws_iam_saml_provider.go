@@ -0,0 +1,189 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsIamSamlProvider(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_iam_saml_provider",
+		Description: "AWS IAM SAML Provider",
+		List: &plugin.ListConfig{
+			Hydrate: listIamSamlProviders,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"NoSuchEntity", "InvalidInput"}),
+			},
+			Hydrate: getIamSamlProvider,
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the SAML provider.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Arn").Transform(lastPathElement),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the SAML provider.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_date",
+				Description: "The date and time when the SAML provider was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "valid_until",
+				Description: "The expiration date and time for the SAML provider.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "saml_metadata_document",
+				Description: "The XML metadata document that includes information about an identity provider.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getIamSamlProviderDetails,
+				Transform:   transform.FromField("SAMLMetadataDocument"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags that are attached to the SAML provider.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getIamSamlProviderDetails,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getIamSamlProviderDetails,
+				Transform:   transform.FromField("Tags").Transform(iamTagsToTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Arn").Transform(lastPathElement),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listIamSamlProviders(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listIamSamlProviders")
+
+	// Create Session
+	svc, err := IAMService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// The API does not support pagination
+	op, err := svc.ListSAMLProviders(&iam.ListSAMLProvidersInput{})
+	if err != nil {
+		plugin.Logger(ctx).Error("listIamSamlProviders", "ListSAMLProviders", err)
+		return nil, err
+	}
+
+	for _, provider := range op.SAMLProviderList {
+		d.StreamListItem(ctx, provider)
+
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getIamSamlProvider(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getIamSamlProvider")
+
+	arn := d.KeyColumnQuals["arn"].GetStringValue()
+
+	// Empty check
+	if arn == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := IAMService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := svc.GetSAMLProvider(&iam.GetSAMLProviderInput{
+		SAMLProviderArn: aws.String(arn),
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("getIamSamlProvider", "GetSAMLProvider", err)
+		return nil, err
+	}
+
+	return &iam.SAMLProviderListEntry{
+		Arn:        aws.String(arn),
+		CreateDate: op.CreateDate,
+		ValidUntil: op.ValidUntil,
+	}, nil
+}
+
+func getIamSamlProviderDetails(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getIamSamlProviderDetails")
+	provider := h.Item.(*iam.SAMLProviderListEntry)
+
+	// Create Session
+	svc, err := IAMService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := svc.GetSAMLProvider(&iam.GetSAMLProviderInput{
+		SAMLProviderArn: provider.Arn,
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("getIamSamlProviderDetails", "GetSAMLProvider", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func iamTagsToTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags := d.Value.([]*iam.Tag)
+	if tags == nil {
+		return nil, nil
+	}
+
+	turbotTagsMap := map[string]string{}
+	for _, i := range tags {
+		turbotTagsMap[*i.Key] = *i.Value
+	}
+
+	return turbotTagsMap, nil
+}
@@ -127,6 +127,27 @@ func tableAwsIamUser(ctx context.Context) *plugin.Table {
 				Hydrate:     getAwsIamUserAttachedPolicies,
 				Transform:   transform.FromValue(),
 			},
+			{
+				Name:        "ssh_public_keys",
+				Description: "A list of SSH public keys associated with the user, used to authenticate to AWS CodeCommit.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsIamUserSSHPublicKeys,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "service_specific_credentials",
+				Description: "A list of service-specific credentials associated with the user.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsIamUserServiceSpecificCredentials,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "signing_certificates",
+				Description: "A list of X.509 signing certificates associated with the user.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsIamUserSigningCertificates,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "tags_src",
 				Description: "A list of tags that are attached to the user.",
@@ -383,6 +404,110 @@ func getAwsIamUserMfaDevices(ctx context.Context, d *plugin.QueryData, h *plugin
 	return userData, nil
 }
 
+func getAwsIamUserSSHPublicKeys(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getAwsIamUserSSHPublicKeys")
+	user := h.Item.(*iam.User)
+
+	// Create Session
+	svc, err := IAMService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &iam.ListSSHPublicKeysInput{
+		UserName: user.UserName,
+	}
+
+	sshPublicKeys := []*iam.SSHPublicKey{}
+	var getErr error
+	err = svc.ListSSHPublicKeysPages(
+		params,
+		func(page *iam.ListSSHPublicKeysOutput, lastPage bool) bool {
+			for _, key := range page.SSHPublicKeys {
+				// The list call does not return the key body and fingerprint
+				op, err := svc.GetSSHPublicKey(&iam.GetSSHPublicKeyInput{
+					UserName:       key.UserName,
+					SSHPublicKeyId: key.SSHPublicKeyId,
+					Encoding:       aws.String(iam.EncodingTypeSsh),
+				})
+				if err != nil {
+					getErr = err
+					return false
+				}
+				sshPublicKeys = append(sshPublicKeys, op.SSHPublicKey)
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("getAwsIamUserSSHPublicKeys", "ListSSHPublicKeysPages", err)
+		return nil, err
+	}
+	if getErr != nil {
+		plugin.Logger(ctx).Error("getAwsIamUserSSHPublicKeys", "GetSSHPublicKey", getErr)
+		return nil, getErr
+	}
+
+	return sshPublicKeys, nil
+}
+
+func getAwsIamUserServiceSpecificCredentials(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getAwsIamUserServiceSpecificCredentials")
+	user := h.Item.(*iam.User)
+
+	// Create Session
+	svc, err := IAMService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &iam.ListServiceSpecificCredentialsInput{
+		UserName: user.UserName,
+	}
+
+	op, err := svc.ListServiceSpecificCredentials(params)
+	if err != nil {
+		plugin.Logger(ctx).Error("getAwsIamUserServiceSpecificCredentials", "ListServiceSpecificCredentials", err)
+		return nil, err
+	}
+
+	if op.ServiceSpecificCredentials == nil {
+		return []*iam.ServiceSpecificCredentialMetadata{}, nil
+	}
+
+	return op.ServiceSpecificCredentials, nil
+}
+
+func getAwsIamUserSigningCertificates(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getAwsIamUserSigningCertificates")
+	user := h.Item.(*iam.User)
+
+	// Create Session
+	svc, err := IAMService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &iam.ListSigningCertificatesInput{
+		UserName: user.UserName,
+	}
+
+	certificates := []*iam.SigningCertificate{}
+	err = svc.ListSigningCertificatesPages(
+		params,
+		func(page *iam.ListSigningCertificatesOutput, lastPage bool) bool {
+			certificates = append(certificates, page.Certificates...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("getAwsIamUserSigningCertificates", "ListSigningCertificatesPages", err)
+		return nil, err
+	}
+
+	return certificates, nil
+}
+
 func listAwsIamUserInlinePolicies(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	plugin.Logger(ctx).Trace("listAwsIamUserInlinePolicies")
 	user := h.Item.(*iam.User)
@@ -405,9 +530,12 @@ func listAwsIamUserInlinePolicies(ctx context.Context, d *plugin.QueryData, h *p
 	var wg sync.WaitGroup
 	policyCh := make(chan map[string]interface{}, len(userData.PolicyNames))
 	errorCh := make(chan error, len(userData.PolicyNames))
+	// Bound the number of inline policies fetched concurrently
+	sem := make(chan struct{}, maxInlinePolicyConcurrency)
 	for _, policy := range userData.PolicyNames {
 		wg.Add(1)
-		go getUserPolicyDataAsync(policy, user.UserName, svc, &wg, policyCh, errorCh)
+		sem <- struct{}{}
+		go getUserPolicyDataAsync(policy, user.UserName, svc, &wg, sem, policyCh, errorCh)
 	}
 
 	// wait for all inline policies to be processed
@@ -429,8 +557,9 @@ func listAwsIamUserInlinePolicies(ctx context.Context, d *plugin.QueryData, h *p
 	return userPolicies, nil
 }
 
-func getUserPolicyDataAsync(policy *string, userName *string, svc *iam.IAM, wg *sync.WaitGroup, policyCh chan map[string]interface{}, errorCh chan error) {
+func getUserPolicyDataAsync(policy *string, userName *string, svc *iam.IAM, wg *sync.WaitGroup, sem chan struct{}, policyCh chan map[string]interface{}, errorCh chan error) {
 	defer wg.Done()
+	defer func() { <-sem }()
 
 	rowData, err := getUserInlinePolicy(policy, userName, svc)
 	if err != nil {
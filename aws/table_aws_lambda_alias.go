@@ -58,6 +58,12 @@ func tableAwsLambdaAlias(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Alias.FunctionVersion"),
 			},
+			{
+				Name:        "routing_config",
+				Description: "The routing configuration of the alias, used to shift traffic between two function versions.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Alias.RoutingConfig"),
+			},
 			{
 				Name:        "revision_id",
 				Description: "A unique identifier that changes when you update the alias.",
@@ -0,0 +1,282 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsLambdaEventSourceMapping(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_lambda_event_source_mapping",
+		Description: "AWS Lambda Event Source Mapping",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("uuid"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getLambdaEventSourceMapping,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listLambdaEventSourceMappings,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "event_source_arn", Require: plugin.Optional},
+				{Name: "function_arn", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "uuid",
+				Description: "The identifier of the event source mapping.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UUID"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the event source mapping.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getLambdaEventSourceMappingARN,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "event_source_arn",
+				Description: "The Amazon Resource Name (ARN) of the event source.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "function_arn",
+				Description: "The ARN of the Lambda function.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the event source mapping, e.g. Creating, Enabled, Disabled, Updating or Deleting.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state_transition_reason",
+				Description: "Indicates whether a user or Lambda made the last change to the event source mapping.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "batch_size",
+				Description: "The maximum number of records in each batch that Lambda pulls from the stream or queue and sends to the function.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "bisect_batch_on_function_error",
+				Description: "If the function returns an error, split the batch in two and retry.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "starting_position",
+				Description: "The position in a stream from which to start reading.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "starting_position_timestamp",
+				Description: "The time from which to start reading, when the starting position is AT_TIMESTAMP.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_modified",
+				Description: "The date that the event source mapping was last updated or that its state changed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_processing_result",
+				Description: "The result of the last Lambda invocation of the function.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "maximum_batching_window_in_seconds",
+				Description: "The maximum amount of time, in seconds, that Lambda spends gathering records before invoking the function.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "maximum_record_age_in_seconds",
+				Description: "Discard records older than the specified age.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "maximum_retry_attempts",
+				Description: "Discard records after the specified number of retries.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "parallelization_factor",
+				Description: "The number of batches to process concurrently from each shard.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "tumbling_window_in_seconds",
+				Description: "The duration in seconds of a processing window for stream sources.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "destination_config",
+				Description: "An Amazon SQS queue or Amazon SNS topic destination for discarded records.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "filter_criteria",
+				Description: "The filter criteria that determine whether Lambda should process an event.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "function_response_types",
+				Description: "A list of current response type enums applied to the event source mapping.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "queues",
+				Description: "The name of the Amazon MQ broker destination queue to consume.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "self_managed_event_source",
+				Description: "The self-managed Apache Kafka cluster for the event source.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "source_access_configurations",
+				Description: "An array of the authentication protocol, VPC components, or virtual host to secure and define the event source.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "topics",
+				Description: "The name of the Kafka topic.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UUID"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getLambdaEventSourceMappingARN,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listLambdaEventSourceMappings(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listLambdaEventSourceMappings")
+
+	// Create Session
+	svc, err := LambdaService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &lambda.ListEventSourceMappingsInput{
+		MaxItems: aws.Int64(10000),
+	}
+
+	equalQuals := d.KeyColumnQuals
+	if equalQuals["event_source_arn"] != nil {
+		input.EventSourceArn = aws.String(equalQuals["event_source_arn"].GetStringValue())
+	}
+	if equalQuals["function_arn"] != nil {
+		input.FunctionName = aws.String(equalQuals["function_arn"].GetStringValue())
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxItems {
+			if *limit < 1 {
+				input.MaxItems = aws.Int64(1)
+			} else {
+				input.MaxItems = limit
+			}
+		}
+	}
+
+	// List call
+	err = svc.ListEventSourceMappingsPages(
+		input,
+		func(page *lambda.ListEventSourceMappingsOutput, lastPage bool) bool {
+			for _, eventSourceMapping := range page.EventSourceMappings {
+				d.StreamListItem(ctx, eventSourceMapping)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("listLambdaEventSourceMappings", "ListEventSourceMappingsPages", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getLambdaEventSourceMapping(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getLambdaEventSourceMapping")
+
+	uuid := d.KeyColumnQuals["uuid"].GetStringValue()
+
+	// Empty check
+	if uuid == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := LambdaService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &lambda.GetEventSourceMappingInput{
+		UUID: aws.String(uuid),
+	}
+
+	op, err := svc.GetEventSourceMapping(params)
+	if err != nil {
+		plugin.Logger(ctx).Error("getLambdaEventSourceMapping", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getLambdaEventSourceMappingARN(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getLambdaEventSourceMappingARN")
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	eventSourceMapping := h.Item.(*lambda.EventSourceMappingConfiguration)
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Build ARN
+	arn := "arn:" + commonColumnData.Partition + ":lambda:" + region + ":" + commonColumnData.AccountId + ":event-source-mapping:" + *eventSourceMapping.UUID
+
+	return arn, nil
+}
@@ -43,7 +43,6 @@ func tableAwsLambdaLayerVersion(_ context.Context) *plugin.Table {
 				Name:        "layer_arn",
 				Description: "The ARN of the layer.",
 				Type:        proto.ColumnType_STRING,
-				Hydrate:     getLambdaLayerVersion,
 			},
 			{
 				Name:        "layer_version_arn",
@@ -141,7 +140,8 @@ func listLambdaLayerVersions(ctx context.Context, d *plugin.QueryData, h *plugin
 		return nil, err
 	}
 
-	layerName := h.Item.(*lambda.LayersListItem).LayerName
+	layer := h.Item.(*lambda.LayersListItem)
+	layerName := layer.LayerName
 
 	equalQuals := d.KeyColumnQuals
 	// Minimize the API call with the given layer name
@@ -185,6 +185,7 @@ func listLambdaLayerVersions(ctx context.Context, d *plugin.QueryData, h *plugin
 					CompatibleRuntimes:      version.CompatibleRuntimes,
 					CreatedDate:             version.CreatedDate,
 					Description:             version.Description,
+					LayerArn:                layer.LayerArn,
 					LayerVersionArn:         version.LayerVersionArn,
 					LicenseInfo:             version.LicenseInfo,
 					Version:                 version.Version,
@@ -0,0 +1,189 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/turbot/go-kit/helpers"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsLightsailBucket(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_lightsail_bucket",
+		Description: "AWS Lightsail Bucket",
+		List: &plugin.ListConfig{
+			Hydrate: listLightsailBuckets,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "name", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"NotFoundException", "DoesNotExist"}),
+			},
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the bucket.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the bucket.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the bucket, either OK or Unknown.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("State.Code"),
+			},
+			{
+				Name:        "created_at",
+				Description: "The timestamp when the bucket was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "url",
+				Description: "The URL of the bucket.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "bundle_id",
+				Description: "The ID of the bundle currently applied to the bucket, which defines its storage space and data transfer quota.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "object_versioning",
+				Description: "Indicates whether object versioning is enabled for the bucket, either Enabled, Suspended or NeverEnabled.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "get_object_access",
+				Description: "Specifies the anonymous access to all objects in the bucket, either public or private.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccessRules.GetObject"),
+			},
+			{
+				Name:        "allow_public_overrides",
+				Description: "Indicates whether the access control list (ACL) permissions that are applied to individual objects override the get_object_access of the bucket.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("AccessRules.AllowPublicOverrides"),
+			},
+			{
+				Name:        "able_to_update_bundle",
+				Description: "Indicates whether the bundle that is currently applied to the bucket can be changed to another bundle.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "support_code",
+				Description: "The support code for the bucket. Include this code in your email to support when you have questions about a bucket in Lightsail.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "access_rules",
+				Description: "The access rules of the bucket.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "access_log_config",
+				Description: "The access log configuration of the bucket.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "readonly_access_accounts",
+				Description: "A list of AWS account IDs that have read-only access to the bucket.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "resources_receiving_access",
+				Description: "A list of Lightsail instances that have access to the bucket.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the bucket.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(lightsailTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listLightsailBuckets(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listLightsailBuckets")
+	region := d.KeyColumnQualString(matrixKeyRegion)
+
+	// AWS Lightsail is not supported in all regions
+	validRegions := SupportedRegionsForService(ctx, d, endpoints.LightsailServiceID)
+	if !helpers.StringSliceContains(validRegions, region) {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := LightsailService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &lightsail.GetBucketsInput{
+		IncludeConnectedResources: aws.Bool(true),
+	}
+	if name := d.KeyColumnQuals["name"].GetStringValue(); name != "" {
+		input.BucketName = aws.String(name)
+	}
+
+	// List call
+	for {
+		op, err := svc.GetBuckets(input)
+		if err != nil {
+			plugin.Logger(ctx).Error("listLightsailBuckets", "GetBuckets_error", err)
+			return nil, err
+		}
+
+		for _, bucket := range op.Buckets {
+			d.StreamListItem(ctx, bucket)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if op.NextPageToken == nil {
+			break
+		}
+		input.PageToken = op.NextPageToken
+	}
+
+	return nil, nil
+}
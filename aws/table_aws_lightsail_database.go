@@ -0,0 +1,275 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/turbot/go-kit/helpers"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsLightsailDatabase(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_lightsail_database",
+		Description: "AWS Lightsail Database",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"NotFoundException", "DoesNotExist"}),
+			},
+			Hydrate: getLightsailDatabase,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listLightsailDatabases,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_at",
+				Description: "The timestamp when the database was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "engine",
+				Description: "The database software, e.g. mysql.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "engine_version",
+				Description: "The database engine version, e.g. 5.7.23.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "publicly_accessible",
+				Description: "Indicates whether the database is publicly accessible.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "backup_retention_enabled",
+				Description: "Indicates whether automated backup retention is enabled for the database.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "availability_zone",
+				Description: "The Availability Zone of the database.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location.AvailabilityZone"),
+			},
+			{
+				Name:        "secondary_availability_zone",
+				Description: "The Availability Zone of the standby database, when the database is in high availability mode.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "blueprint_id",
+				Description: "The blueprint ID for the database, which specifies its engine, e.g. mysql_8_0.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RelationalDatabaseBlueprintId"),
+			},
+			{
+				Name:        "bundle_id",
+				Description: "The bundle ID for the database, which specifies its size and performance parameters.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RelationalDatabaseBundleId"),
+			},
+			{
+				Name:        "master_database_name",
+				Description: "The name of the master database created when the Lightsail database resource is created.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "master_username",
+				Description: "The master user name of the database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "master_endpoint_address",
+				Description: "The address of the master endpoint of the database.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MasterEndpoint.Address"),
+			},
+			{
+				Name:        "master_endpoint_port",
+				Description: "The port of the master endpoint of the database.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("MasterEndpoint.Port"),
+			},
+			{
+				Name:        "ca_certificate_identifier",
+				Description: "The certificate associated with the database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "preferred_backup_window",
+				Description: "The daily time range during which automated backups are created for the database, if automated backups are enabled.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "preferred_maintenance_window",
+				Description: "The weekly time range during which system maintenance can occur on the database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "latest_restorable_time",
+				Description: "The latest point in time to which the database can be restored.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "parameter_apply_status",
+				Description: "The status of parameter updates for the database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "support_code",
+				Description: "The support code for the database. Include this code in your email to support when you have questions about a database in Lightsail.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "hardware",
+				Description: "The hardware of the database, including its vCPU count, disk size and RAM.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "pending_maintenance_actions",
+				Description: "The pending maintenance actions for the database.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "pending_modified_values",
+				Description: "The values of the database that are pending to be modified.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the database.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(lightsailTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listLightsailDatabases(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listLightsailDatabases")
+	region := d.KeyColumnQualString(matrixKeyRegion)
+
+	// AWS Lightsail is not supported in all regions
+	validRegions := SupportedRegionsForService(ctx, d, endpoints.LightsailServiceID)
+	if !helpers.StringSliceContains(validRegions, region) {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := LightsailService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &lightsail.GetRelationalDatabasesInput{}
+
+	// List call
+	for {
+		op, err := svc.GetRelationalDatabases(input)
+		if err != nil {
+			plugin.Logger(ctx).Error("listLightsailDatabases", "GetRelationalDatabases_error", err)
+			return nil, err
+		}
+
+		for _, database := range op.RelationalDatabases {
+			d.StreamListItem(ctx, database)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if op.NextPageToken == nil {
+			break
+		}
+		input.PageToken = op.NextPageToken
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getLightsailDatabase(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getLightsailDatabase")
+	region := d.KeyColumnQualString(matrixKeyRegion)
+
+	// AWS Lightsail is not supported in all regions
+	validRegions := SupportedRegionsForService(ctx, d, endpoints.LightsailServiceID)
+	if !helpers.StringSliceContains(validRegions, region) {
+		return nil, nil
+	}
+
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := LightsailService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &lightsail.GetRelationalDatabaseInput{
+		RelationalDatabaseName: aws.String(name),
+	}
+
+	op, err := svc.GetRelationalDatabase(params)
+	if err != nil {
+		plugin.Logger(ctx).Debug("getLightsailDatabase", "ERROR", err)
+		return nil, err
+	}
+
+	return op.RelationalDatabase, nil
+}
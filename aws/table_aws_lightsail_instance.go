@@ -0,0 +1,271 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/turbot/go-kit/helpers"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsLightsailInstance(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_lightsail_instance",
+		Description: "AWS Lightsail Instance",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"NotFoundException", "DoesNotExist"}),
+			},
+			Hydrate: getLightsailInstance,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listLightsailInstances,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the instance, e.g. pending, running, stopping or stopped.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("State.Name"),
+			},
+			{
+				Name:        "created_at",
+				Description: "The timestamp when the instance was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "blueprint_id",
+				Description: "The blueprint ID, e.g. os_amlinux_2016_03.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "blueprint_name",
+				Description: "The friendly name of the blueprint, e.g. Amazon Linux.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "bundle_id",
+				Description: "The bundle for the instance, e.g. micro_1_0.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "availability_zone",
+				Description: "The Availability Zone of the instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location.AvailabilityZone"),
+			},
+			{
+				Name:        "public_ip_address",
+				Description: "The public IP address of the instance.",
+				Type:        proto.ColumnType_IPADDR,
+			},
+			{
+				Name:        "private_ip_address",
+				Description: "The private IP address of the instance.",
+				Type:        proto.ColumnType_IPADDR,
+			},
+			{
+				Name:        "ip_address_type",
+				Description: "The IP address type of the instance, either ipv4 or dualstack.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_static_ip",
+				Description: "Indicates whether this instance has a static IP assigned to it.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "username",
+				Description: "The user name for connecting to the instance, e.g. ec2-user.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ssh_key_name",
+				Description: "The name of the SSH key being used to connect to the instance, e.g. LightsailDefaultKeyPair.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "support_code",
+				Description: "The support code. Include this code in your email to support when you have questions about an instance or another resource in Lightsail.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cpu_count",
+				Description: "The number of vCPUs the instance has.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Hardware.CpuCount"),
+			},
+			{
+				Name:        "ram_size_in_gb",
+				Description: "The amount of RAM in GB on the instance.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Hardware.RamSizeInGb"),
+			},
+			{
+				Name:        "ipv6_addresses",
+				Description: "The IPv6 addresses of the instance.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "add_ons",
+				Description: "The add-ons enabled on the instance, e.g. automatic snapshots.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "hardware",
+				Description: "The size of the vCPU and the amount of RAM for the instance, and the disks attached to it.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "networking",
+				Description: "Information about the public ports of the instance and the rules allowing access to them, and the monthly data transfer.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the instance.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(lightsailTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listLightsailInstances(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listLightsailInstances")
+	region := d.KeyColumnQualString(matrixKeyRegion)
+
+	// AWS Lightsail is not supported in all regions
+	validRegions := SupportedRegionsForService(ctx, d, endpoints.LightsailServiceID)
+	if !helpers.StringSliceContains(validRegions, region) {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := LightsailService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &lightsail.GetInstancesInput{}
+
+	// List call
+	for {
+		op, err := svc.GetInstances(input)
+		if err != nil {
+			plugin.Logger(ctx).Error("listLightsailInstances", "GetInstances_error", err)
+			return nil, err
+		}
+
+		for _, instance := range op.Instances {
+			d.StreamListItem(ctx, instance)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if op.NextPageToken == nil {
+			break
+		}
+		input.PageToken = op.NextPageToken
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getLightsailInstance(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getLightsailInstance")
+	region := d.KeyColumnQualString(matrixKeyRegion)
+
+	// AWS Lightsail is not supported in all regions
+	validRegions := SupportedRegionsForService(ctx, d, endpoints.LightsailServiceID)
+	if !helpers.StringSliceContains(validRegions, region) {
+		return nil, nil
+	}
+
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := LightsailService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &lightsail.GetInstanceInput{
+		InstanceName: aws.String(name),
+	}
+
+	op, err := svc.GetInstance(params)
+	if err != nil {
+		plugin.Logger(ctx).Debug("getLightsailInstance", "ERROR", err)
+		return nil, err
+	}
+
+	return op.Instance, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func lightsailTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.Value.([]*lightsail.Tag)
+	if !ok || len(tags) == 0 {
+		return nil, nil
+	}
+
+	// Lightsail tags can be key-only
+	turbotTagsMap := map[string]string{}
+	for _, i := range tags {
+		turbotTagsMap[*i.Key] = aws.StringValue(i.Value)
+	}
+
+	return turbotTagsMap, nil
+}
@@ -0,0 +1,306 @@
+package aws
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsMacie2Finding(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_macie2_finding",
+		Description: "AWS Macie2 Finding",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ValidationException", "ResourceNotFoundException"}),
+			},
+			Hydrate: getMacie2Finding,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listMacie2Findings,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "archived", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+				{Name: "category", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+				{Name: "severity_description", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+				{Name: "type", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+			},
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The unique identifier for the finding.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the finding, e.g. SensitiveData:S3Object/Personal or Policy:IAMUser/S3BucketPublic.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "category",
+				Description: "The category of the finding, either CLASSIFICATION (sensitive data finding) or POLICY (policy finding).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "severity_description",
+				Description: "The qualitative representation of the finding's severity, either Low, Medium or High.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Severity.Description"),
+			},
+			{
+				Name:        "severity_score",
+				Description: "The numerical representation of the finding's severity, ranging from 1 (least severe) to 3 (most severe).",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Severity.Score"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the finding.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "archived",
+				Description: "Specifies whether the finding is archived (suppressed).",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "sample",
+				Description: "Specifies whether the finding is a sample finding.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "count",
+				Description: "The total number of occurrences of the finding.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time when Amazon Macie created the finding.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "updated_at",
+				Description: "The date and time when Amazon Macie last updated the finding.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "schema_version",
+				Description: "The version of the schema that was used to define the data structures in the finding.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "s3_bucket_name",
+				Description: "The name of the S3 bucket that the finding applies to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourcesAffected.S3Bucket.Name"),
+			},
+			{
+				Name:        "s3_object_key",
+				Description: "The full key (name) of the S3 object that the finding applies to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourcesAffected.S3Object.Key"),
+			},
+			{
+				Name:        "resources_affected",
+				Description: "The details of the affected S3 bucket and, for sensitive data findings, the affected S3 object.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "classification_details",
+				Description: "The details of a sensitive data finding, including the classification job that produced it and the sensitive data that was detected.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "policy_details",
+				Description: "The details of a policy finding, including the action and the actor that caused it.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listMacie2Findings(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listMacie2Findings")
+
+	// Create Session
+	svc, err := Macie2Service(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// GetFindings accepts at most 50 finding IDs per call, so each page of
+	// IDs returned by ListFindings is fetched with a single GetFindings call
+	input := &macie2.ListFindingsInput{
+		MaxResults: aws.Int64(50),
+	}
+
+	findingCriteria := buildMacie2FindingCriteria(d.Quals)
+	if len(findingCriteria.Criterion) > 0 {
+		input.FindingCriteria = findingCriteria
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxResults {
+			if *limit < 1 {
+				input.MaxResults = aws.Int64(1)
+			} else {
+				input.MaxResults = limit
+			}
+		}
+	}
+
+	// List call
+	var getErr error
+	err = svc.ListFindingsPages(
+		input,
+		func(page *macie2.ListFindingsOutput, isLast bool) bool {
+			if len(page.FindingIds) == 0 {
+				return !isLast
+			}
+
+			op, err := svc.GetFindings(&macie2.GetFindingsInput{
+				FindingIds: page.FindingIds,
+			})
+			if err != nil {
+				getErr = err
+				return false
+			}
+
+			for _, finding := range op.Findings {
+				d.StreamListItem(ctx, finding)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+	if err == nil {
+		err = getErr
+	}
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Throws "AccessDeniedException: Macie is not enabled." when AWS Macie is not enabled in a region
+			// also the API throws AccessDeniedException if the request does not have proper permission
+			// with the below check we will only handle "Macie is not enabled"
+			if awsErr.Message() == "Macie is not enabled." {
+				return nil, nil
+			}
+		}
+		plugin.Logger(ctx).Error("listMacie2Findings", "ListFindingsPages_error", err)
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getMacie2Finding(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getMacie2Finding")
+
+	id := d.KeyColumnQuals["id"].GetStringValue()
+
+	// empty check for finding id
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := Macie2Service(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build params
+	params := &macie2.GetFindingsInput{
+		FindingIds: []*string{aws.String(id)},
+	}
+
+	// Get call
+	op, err := svc.GetFindings(params)
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Throws "AccessDeniedException: Macie is not enabled." when AWS Macie is not enabled in a region
+			if awsErr.Message() == "Macie is not enabled." {
+				return nil, nil
+			}
+		}
+		plugin.Logger(ctx).Error("getMacie2Finding", "GetFindings_error", err)
+		return nil, err
+	}
+
+	if len(op.Findings) > 0 {
+		return op.Findings[0], nil
+	}
+	return nil, nil
+}
+
+//// UTILITY FUNCTION
+//// Build macie2 list findings criteria
+
+func buildMacie2FindingCriteria(quals plugin.KeyColumnQualMap) *macie2.FindingCriteria {
+	criteria := map[string]*macie2.CriterionAdditionalProperties{}
+
+	filterQuals := map[string]string{
+		"archived":             "archived",
+		"category":             "category",
+		"severity_description": "severity.description",
+		"type":                 "type",
+	}
+
+	for columnName, filterName := range filterQuals {
+		if quals[columnName] == nil {
+			continue
+		}
+		for _, q := range quals[columnName].Quals {
+			var values []*string
+			if columnName == "archived" {
+				values = []*string{aws.String(strconv.FormatBool(q.Value.GetBoolValue()))}
+			} else if q.Value.GetStringValue() != "" {
+				values = []*string{aws.String(q.Value.GetStringValue())}
+			} else {
+				values = getListValues(q.Value.GetListValue())
+			}
+			if len(values) == 0 {
+				continue
+			}
+
+			criterion, ok := criteria[filterName]
+			if !ok {
+				criterion = &macie2.CriterionAdditionalProperties{}
+				criteria[filterName] = criterion
+			}
+			switch q.Operator {
+			case "=":
+				criterion.Eq = append(criterion.Eq, values...)
+			case "<>":
+				criterion.Neq = append(criterion.Neq, values...)
+			}
+		}
+	}
+
+	return &macie2.FindingCriteria{Criterion: criteria}
+}
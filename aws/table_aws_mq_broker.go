@@ -0,0 +1,266 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mq"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsMQBroker(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_mq_broker",
+		Description: "AWS MQ Broker",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("broker_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"NotFoundException"}),
+			},
+			Hydrate: getMQBroker,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listMQBrokers,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "broker_name",
+				Description: "The name of the broker.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "broker_id",
+				Description: "The unique ID that Amazon MQ generates for the broker.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the broker.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BrokerArn"),
+			},
+			{
+				Name:        "broker_state",
+				Description: "The broker's status, e.g. CREATION_IN_PROGRESS, CREATION_FAILED, DELETION_IN_PROGRESS, RUNNING or REBOOT_IN_PROGRESS.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created",
+				Description: "The time when the broker was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "deployment_mode",
+				Description: "The broker's deployment mode, e.g. SINGLE_INSTANCE, ACTIVE_STANDBY_MULTI_AZ or CLUSTER_MULTI_AZ.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "engine_type",
+				Description: "The type of broker engine, either ACTIVEMQ or RABBITMQ.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "host_instance_type",
+				Description: "The broker's instance type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "engine_version",
+				Description: "The broker engine's version.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getMQBroker,
+			},
+			{
+				Name:        "publicly_accessible",
+				Description: "Indicates whether the broker is accessible from outside its VPC.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getMQBroker,
+			},
+			{
+				Name:        "auto_minor_version_upgrade",
+				Description: "Indicates whether minor engine upgrades are applied automatically to the broker during the maintenance window.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getMQBroker,
+			},
+			{
+				Name:        "authentication_strategy",
+				Description: "The authentication strategy used to secure the broker, either SIMPLE or LDAP.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getMQBroker,
+			},
+			{
+				Name:        "storage_type",
+				Description: "The broker's storage type, either EBS or EFS.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getMQBroker,
+			},
+			{
+				Name:        "pending_engine_version",
+				Description: "The broker engine version to upgrade to during the next maintenance window.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getMQBroker,
+			},
+			{
+				Name:        "pending_host_instance_type",
+				Description: "The broker's host instance type to upgrade to during the next maintenance window.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getMQBroker,
+			},
+			{
+				Name:        "broker_instances",
+				Description: "A list of information about allocated brokers, including console URLs and endpoints.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getMQBroker,
+			},
+			{
+				Name:        "configurations",
+				Description: "The list of all revisions for the specified configuration.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getMQBroker,
+			},
+			{
+				Name:        "encryption_options",
+				Description: "Encryption options for the broker.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getMQBroker,
+			},
+			{
+				Name:        "ldap_server_metadata",
+				Description: "The metadata of the LDAP server used to authenticate and authorize connections to the broker.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getMQBroker,
+			},
+			{
+				Name:        "logs",
+				Description: "The list of information about logs currently enabled and pending to be deployed for the broker.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getMQBroker,
+			},
+			{
+				Name:        "maintenance_window_start_time",
+				Description: "The parameters that determine the WeeklyStartTime.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getMQBroker,
+			},
+			{
+				Name:        "security_groups",
+				Description: "The list of rules (1 minimum, 125 maximum) that authorize connections to brokers.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getMQBroker,
+			},
+			{
+				Name:        "subnet_ids",
+				Description: "The list of groups that define which subnets and IP ranges the broker can use from different Availability Zones.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getMQBroker,
+			},
+			{
+				Name:        "users",
+				Description: "The list of all broker usernames for the broker.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getMQBroker,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BrokerName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getMQBroker,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("BrokerArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listMQBrokers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := MQService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &mq.ListBrokersInput{
+		MaxResults: aws.Int64(100),
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxResults {
+			if *limit < 1 {
+				input.MaxResults = aws.Int64(1)
+			} else {
+				input.MaxResults = limit
+			}
+		}
+	}
+
+	err = svc.ListBrokersPages(
+		input,
+		func(page *mq.ListBrokersResponse, isLast bool) bool {
+			for _, broker := range page.BrokerSummaries {
+				d.StreamListItem(ctx, broker)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getMQBroker(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getMQBroker")
+
+	var brokerID string
+	if h.Item != nil {
+		brokerID = *h.Item.(*mq.BrokerSummary).BrokerId
+	} else {
+		brokerID = d.KeyColumnQuals["broker_id"].GetStringValue()
+	}
+
+	// Create Session
+	svc, err := MQService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &mq.DescribeBrokerInput{
+		BrokerId: aws.String(brokerID),
+	}
+
+	op, err := svc.DescribeBroker(params)
+	if err != nil {
+		plugin.Logger(ctx).Debug("getMQBroker", "ERROR", err)
+		return nil, err
+	}
+
+	return op, nil
+}
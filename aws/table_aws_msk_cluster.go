@@ -0,0 +1,306 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafka"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsMSKCluster(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_msk_cluster",
+		Description: "AWS Managed Streaming for Kafka Cluster",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"NotFoundException", "BadRequestException"}),
+			},
+			Hydrate: getMSKCluster,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listMSKClusters,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "cluster_name", Require: plugin.Optional},
+				{Name: "cluster_type", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "cluster_name",
+				Description: "The name of the cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) that uniquely identifies the cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ClusterArn"),
+			},
+			{
+				Name:        "cluster_type",
+				Description: "The type of the cluster, either PROVISIONED or SERVERLESS.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the cluster, e.g. ACTIVE, CREATING, DELETING, FAILED, HEALING, MAINTENANCE, REBOOTING_BROKER or UPDATING.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The time when the cluster was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "current_version",
+				Description: "The current version of the cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "active_operation_arn",
+				Description: "The Amazon Resource Name (ARN) of the operation that is currently in progress on the cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kafka_version",
+				Description: "The Apache Kafka version running on the brokers of a provisioned cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Provisioned.CurrentBrokerSoftwareInfo.KafkaVersion"),
+			},
+			{
+				Name:        "number_of_broker_nodes",
+				Description: "The number of broker nodes in a provisioned cluster.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Provisioned.NumberOfBrokerNodes"),
+			},
+			{
+				Name:        "enhanced_monitoring",
+				Description: "The level of monitoring for a provisioned cluster, e.g. DEFAULT, PER_BROKER, PER_TOPIC_PER_BROKER or PER_TOPIC_PER_PARTITION.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Provisioned.EnhancedMonitoring"),
+			},
+			{
+				Name:        "zookeeper_connect_string",
+				Description: "The connection string to use to connect to the Apache ZooKeeper cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Provisioned.ZookeeperConnectString"),
+			},
+			{
+				Name:        "zookeeper_connect_string_tls",
+				Description: "The connection string to use to connect to the Apache ZooKeeper cluster on a TLS port.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Provisioned.ZookeeperConnectStringTls"),
+			},
+			{
+				Name:        "broker_node_group_info",
+				Description: "Information about the broker nodes of a provisioned cluster.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Provisioned.BrokerNodeGroupInfo"),
+			},
+			{
+				Name:        "client_authentication",
+				Description: "Includes all client authentication related information, i.e. SASL, TLS and unauthenticated access.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Provisioned.ClientAuthentication", "Serverless.ClientAuthentication"),
+			},
+			{
+				Name:        "current_broker_software_info",
+				Description: "Information about the version of software currently deployed on the brokers of a provisioned cluster.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Provisioned.CurrentBrokerSoftwareInfo"),
+			},
+			{
+				Name:        "encryption_info",
+				Description: "Includes all encryption related information, i.e. encryption at rest and in transit.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Provisioned.EncryptionInfo"),
+			},
+			{
+				Name:        "logging_info",
+				Description: "The broker log delivery configuration of a provisioned cluster.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Provisioned.LoggingInfo"),
+			},
+			{
+				Name:        "open_monitoring",
+				Description: "The settings for open monitoring with Prometheus.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Provisioned.OpenMonitoring"),
+			},
+			{
+				Name:        "vpc_configs",
+				Description: "The VPC configurations of a serverless cluster.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Serverless.VpcConfigs"),
+			},
+			{
+				Name:        "state_info",
+				Description: "Information about the state of the cluster, including the error code and message of a failed operation.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "configuration",
+				Description: "The MSK configuration revision currently applied to the brokers of a provisioned cluster.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getMSKClusterConfiguration,
+				Transform:   transform.FromValue().Transform(mskClusterConfiguration),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ClusterName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ClusterArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listMSKClusters(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := KafkaService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// ListClustersV2 returns both provisioned and serverless clusters
+	input := &kafka.ListClustersV2Input{
+		MaxResults: aws.Int64(100),
+	}
+
+	// Additional Filter
+	equalQuals := d.KeyColumnQuals
+	if equalQuals["cluster_name"] != nil {
+		input.ClusterNameFilter = aws.String(equalQuals["cluster_name"].GetStringValue())
+	}
+	if equalQuals["cluster_type"] != nil {
+		input.ClusterTypeFilter = aws.String(equalQuals["cluster_type"].GetStringValue())
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxResults {
+			if *limit < 1 {
+				input.MaxResults = aws.Int64(1)
+			} else {
+				input.MaxResults = limit
+			}
+		}
+	}
+
+	err = svc.ListClustersV2Pages(
+		input,
+		func(page *kafka.ListClustersV2Output, isLast bool) bool {
+			for _, cluster := range page.ClusterInfoList {
+				d.StreamListItem(ctx, cluster)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getMSKCluster(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getMSKCluster")
+
+	arn := d.KeyColumnQuals["arn"].GetStringValue()
+
+	// Create Session
+	svc, err := KafkaService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &kafka.DescribeClusterV2Input{
+		ClusterArn: aws.String(arn),
+	}
+
+	op, err := svc.DescribeClusterV2(params)
+	if err != nil {
+		plugin.Logger(ctx).Debug("getMSKCluster", "ERROR", err)
+		return nil, err
+	}
+
+	return op.ClusterInfo, nil
+}
+
+func getMSKClusterConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getMSKClusterConfiguration")
+
+	cluster := h.Item.(*kafka.Cluster)
+
+	// Serverless clusters and clusters using the default MSK configuration
+	// do not have a configuration revision
+	if cluster.Provisioned == nil || cluster.Provisioned.CurrentBrokerSoftwareInfo == nil || cluster.Provisioned.CurrentBrokerSoftwareInfo.ConfigurationArn == nil {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := KafkaService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &kafka.DescribeConfigurationRevisionInput{
+		Arn:      cluster.Provisioned.CurrentBrokerSoftwareInfo.ConfigurationArn,
+		Revision: cluster.Provisioned.CurrentBrokerSoftwareInfo.ConfigurationRevision,
+	}
+
+	op, err := svc.DescribeConfigurationRevision(params)
+	if err != nil {
+		plugin.Logger(ctx).Debug("getMSKClusterConfiguration", "ERROR", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func mskClusterConfiguration(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	configuration, ok := d.Value.(*kafka.DescribeConfigurationRevisionOutput)
+	if !ok || configuration == nil {
+		return nil, nil
+	}
+
+	// The server properties are returned as raw bytes, expose them as plain text
+	return map[string]interface{}{
+		"Arn":              configuration.Arn,
+		"CreationTime":     configuration.CreationTime,
+		"Description":      configuration.Description,
+		"Revision":         configuration.Revision,
+		"ServerProperties": string(configuration.ServerProperties),
+	}, nil
+}
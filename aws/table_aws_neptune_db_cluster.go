@@ -260,9 +260,16 @@ func listNeptuneDBClusters(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 		return nil, err
 	}
 
-	// Filter parameter is not supported yet in this SDK version so optional quals can not be implemented
+	// The Neptune API also returns the clusters of other RDS engines, e.g. Aurora
+	// and DocumentDB, so restrict the list to Neptune clusters
 	input := &neptune.DescribeDBClustersInput{
 		MaxRecords: aws.Int64(100),
+		Filters: []*neptune.Filter{
+			{
+				Name:   aws.String("engine"),
+				Values: []*string{aws.String("neptune")},
+			},
+		},
 	}
 
 	// Reduce the basic request limit down if the user has only requested a small number of rows
@@ -320,7 +327,7 @@ func getNeptuneDBCluster(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 		logger.Error("getNeptuneDBCluster", "ERROR", err)
 		return nil, err
 	}
-	if len(data.DBClusters) > 0 {
+	if len(data.DBClusters) > 0 && *data.DBClusters[0].Engine == "neptune" {
 		return data.DBClusters[0], nil
 	}
 	return nil, nil
@@ -0,0 +1,269 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsNetworkFirewallFirewall(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_networkfirewall_firewall",
+		Description: "AWS Network Firewall Firewall",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AnyColumn([]string{"arn", "firewall_name"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFoundException", "InvalidRequestException"}),
+			},
+			Hydrate: getNetworkFirewallFirewall,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listNetworkFirewallFirewalls,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "vpc_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "firewall_name",
+				Description: "The descriptive name of the firewall.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FirewallName", "Firewall.FirewallName"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the firewall.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FirewallArn", "Firewall.FirewallArn"),
+			},
+			{
+				Name:        "firewall_id",
+				Description: "The unique identifier for the firewall.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getNetworkFirewallFirewall,
+				Transform:   transform.FromField("Firewall.FirewallId"),
+			},
+			{
+				Name:        "description",
+				Description: "A description of the firewall.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getNetworkFirewallFirewall,
+				Transform:   transform.FromField("Firewall.Description"),
+			},
+			{
+				Name:        "vpc_id",
+				Description: "The unique identifier of the VPC where the firewall is in use.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getNetworkFirewallFirewall,
+				Transform:   transform.FromField("Firewall.VpcId"),
+			},
+			{
+				Name:        "firewall_policy_arn",
+				Description: "The Amazon Resource Name (ARN) of the firewall policy.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getNetworkFirewallFirewall,
+				Transform:   transform.FromField("Firewall.FirewallPolicyArn"),
+			},
+			{
+				Name:        "delete_protection",
+				Description: "Indicates whether the firewall is protected against deletion.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getNetworkFirewallFirewall,
+				Transform:   transform.FromField("Firewall.DeleteProtection"),
+			},
+			{
+				Name:        "subnet_change_protection",
+				Description: "Indicates whether the firewall is protected against changes to its subnet associations.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getNetworkFirewallFirewall,
+				Transform:   transform.FromField("Firewall.SubnetChangeProtection"),
+			},
+			{
+				Name:        "firewall_policy_change_protection",
+				Description: "Indicates whether the firewall is protected against a change to the firewall policy association.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getNetworkFirewallFirewall,
+				Transform:   transform.FromField("Firewall.FirewallPolicyChangeProtection"),
+			},
+			{
+				Name:        "status",
+				Description: "The readiness of the firewall, either PROVISIONING, DELETING or READY.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getNetworkFirewallFirewall,
+				Transform:   transform.FromField("FirewallStatus.Status"),
+			},
+			{
+				Name:        "configuration_sync_state_summary",
+				Description: "Indicates whether the firewall configuration and rules are in sync in all the Availability Zones, either PENDING or IN_SYNC.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getNetworkFirewallFirewall,
+				Transform:   transform.FromField("FirewallStatus.ConfigurationSyncStateSummary"),
+			},
+			{
+				Name:        "sync_states",
+				Description: "The subnet attachment and configuration sync state of the firewall in each Availability Zone, keyed by zone name.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getNetworkFirewallFirewall,
+				Transform:   transform.FromField("FirewallStatus.SyncStates"),
+			},
+			{
+				Name:        "subnet_mappings",
+				Description: "The public subnets that Network Firewall is using for the firewall.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getNetworkFirewallFirewall,
+				Transform:   transform.FromField("Firewall.SubnetMappings"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the resource.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getNetworkFirewallFirewall,
+				Transform:   transform.FromField("Firewall.Tags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FirewallName", "Firewall.FirewallName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getNetworkFirewallFirewall,
+				Transform:   transform.FromField("Firewall.Tags").Transform(networkFirewallTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("FirewallArn", "Firewall.FirewallArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listNetworkFirewallFirewalls(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listNetworkFirewallFirewalls")
+
+	// Create session
+	svc, err := NetworkFirewallService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &networkfirewall.ListFirewallsInput{
+		MaxResults: aws.Int64(100),
+	}
+
+	if d.KeyColumnQuals["vpc_id"] != nil {
+		input.VpcIds = []*string{aws.String(d.KeyColumnQuals["vpc_id"].GetStringValue())}
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxResults {
+			if *limit < 1 {
+				input.MaxResults = aws.Int64(1)
+			} else {
+				input.MaxResults = limit
+			}
+		}
+	}
+
+	// List call
+	err = svc.ListFirewallsPages(
+		input,
+		func(page *networkfirewall.ListFirewallsOutput, isLast bool) bool {
+			for _, firewall := range page.Firewalls {
+				d.StreamListItem(ctx, firewall)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getNetworkFirewallFirewall(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	logger.Trace("getNetworkFirewallFirewall")
+
+	var name, arn string
+	if h.Item != nil {
+		switch item := h.Item.(type) {
+		case *networkfirewall.FirewallMetadata:
+			name = aws.StringValue(item.FirewallName)
+			arn = aws.StringValue(item.FirewallArn)
+		case *networkfirewall.DescribeFirewallOutput:
+			return item, nil
+		}
+	} else {
+		name = d.KeyColumnQuals["firewall_name"].GetStringValue()
+		arn = d.KeyColumnQuals["arn"].GetStringValue()
+	}
+
+	// Create session
+	svc, err := NetworkFirewallService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the params
+	// Can pass in ARN, name, or both
+	params := &networkfirewall.DescribeFirewallInput{}
+	if name != "" {
+		params.FirewallName = aws.String(name)
+	}
+	if arn != "" {
+		params.FirewallArn = aws.String(arn)
+	}
+
+	// Get call
+	data, err := svc.DescribeFirewall(params)
+	if err != nil {
+		logger.Debug("getNetworkFirewallFirewall", "ERROR", err)
+		return nil, err
+	}
+	return data, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func networkFirewallTagListToTurbotTags(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("networkFirewallTagListToTurbotTags")
+	tags, ok := d.Value.([]*networkfirewall.Tag)
+	if !ok {
+		return nil, nil
+	}
+
+	// Mapping the resource tags inside turbotTags
+	var turbotTagsMap map[string]string
+	if tags != nil {
+		turbotTagsMap = map[string]string{}
+		for _, i := range tags {
+			turbotTagsMap[*i.Key] = *i.Value
+		}
+	}
+
+	return turbotTagsMap, nil
+}
@@ -0,0 +1,255 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsNetworkFirewallFirewallPolicy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_networkfirewall_firewall_policy",
+		Description: "AWS Network Firewall Firewall Policy",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AnyColumn([]string{"arn", "name"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFoundException", "InvalidRequestException"}),
+			},
+			Hydrate: getNetworkFirewallFirewallPolicy,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listNetworkFirewallFirewallPolicies,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The descriptive name of the firewall policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name", "FirewallPolicyResponse.FirewallPolicyName"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the firewall policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Arn", "FirewallPolicyResponse.FirewallPolicyArn"),
+			},
+			{
+				Name:        "firewall_policy_id",
+				Description: "The unique identifier for the firewall policy.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getNetworkFirewallFirewallPolicy,
+				Transform:   transform.FromField("FirewallPolicyResponse.FirewallPolicyId"),
+			},
+			{
+				Name:        "firewall_policy_status",
+				Description: "The current status of the firewall policy, either ACTIVE or DELETING.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getNetworkFirewallFirewallPolicy,
+				Transform:   transform.FromField("FirewallPolicyResponse.FirewallPolicyStatus"),
+			},
+			{
+				Name:        "description",
+				Description: "A description of the firewall policy.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getNetworkFirewallFirewallPolicy,
+				Transform:   transform.FromField("FirewallPolicyResponse.Description"),
+			},
+			{
+				Name:        "number_of_associations",
+				Description: "The number of firewalls that use this firewall policy.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getNetworkFirewallFirewallPolicy,
+				Transform:   transform.FromField("FirewallPolicyResponse.NumberOfAssociations"),
+			},
+			{
+				Name:        "consumed_stateful_rule_capacity",
+				Description: "The number of capacity units currently consumed by the stateful rule groups of the policy.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getNetworkFirewallFirewallPolicy,
+				Transform:   transform.FromField("FirewallPolicyResponse.ConsumedStatefulRuleCapacity"),
+			},
+			{
+				Name:        "consumed_stateless_rule_capacity",
+				Description: "The number of capacity units currently consumed by the stateless rule groups of the policy.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getNetworkFirewallFirewallPolicy,
+				Transform:   transform.FromField("FirewallPolicyResponse.ConsumedStatelessRuleCapacity"),
+			},
+			{
+				Name:        "stateless_default_actions",
+				Description: "The actions to take on a packet if it doesn't match any of the stateless rules in the policy.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getNetworkFirewallFirewallPolicy,
+				Transform:   transform.FromField("FirewallPolicy.StatelessDefaultActions"),
+			},
+			{
+				Name:        "stateless_fragment_default_actions",
+				Description: "The actions to take on a fragmented UDP packet if it doesn't match any of the stateless rules in the policy.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getNetworkFirewallFirewallPolicy,
+				Transform:   transform.FromField("FirewallPolicy.StatelessFragmentDefaultActions"),
+			},
+			{
+				Name:        "stateless_custom_actions",
+				Description: "The custom action definitions that are available for use in the stateless default actions of the policy.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getNetworkFirewallFirewallPolicy,
+				Transform:   transform.FromField("FirewallPolicy.StatelessCustomActions"),
+			},
+			{
+				Name:        "stateless_rule_group_references",
+				Description: "References to the stateless rule groups that are used in the policy, with their priorities.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getNetworkFirewallFirewallPolicy,
+				Transform:   transform.FromField("FirewallPolicy.StatelessRuleGroupReferences"),
+			},
+			{
+				Name:        "stateful_default_actions",
+				Description: "The default actions to take on a packet that doesn't match any stateful rules, when the rule order is strict.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getNetworkFirewallFirewallPolicy,
+				Transform:   transform.FromField("FirewallPolicy.StatefulDefaultActions"),
+			},
+			{
+				Name:        "stateful_engine_options",
+				Description: "Additional options governing how Network Firewall handles stateful rules, e.g. the rule order.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getNetworkFirewallFirewallPolicy,
+				Transform:   transform.FromField("FirewallPolicy.StatefulEngineOptions"),
+			},
+			{
+				Name:        "stateful_rule_group_references",
+				Description: "References to the stateful rule groups that are used in the policy.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getNetworkFirewallFirewallPolicy,
+				Transform:   transform.FromField("FirewallPolicy.StatefulRuleGroupReferences"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the resource.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getNetworkFirewallFirewallPolicy,
+				Transform:   transform.FromField("FirewallPolicyResponse.Tags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name", "FirewallPolicyResponse.FirewallPolicyName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getNetworkFirewallFirewallPolicy,
+				Transform:   transform.FromField("FirewallPolicyResponse.Tags").Transform(networkFirewallTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn", "FirewallPolicyResponse.FirewallPolicyArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listNetworkFirewallFirewallPolicies(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listNetworkFirewallFirewallPolicies")
+
+	// Create session
+	svc, err := NetworkFirewallService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &networkfirewall.ListFirewallPoliciesInput{
+		MaxResults: aws.Int64(100),
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxResults {
+			if *limit < 1 {
+				input.MaxResults = aws.Int64(1)
+			} else {
+				input.MaxResults = limit
+			}
+		}
+	}
+
+	// List call
+	err = svc.ListFirewallPoliciesPages(
+		input,
+		func(page *networkfirewall.ListFirewallPoliciesOutput, isLast bool) bool {
+			for _, policy := range page.FirewallPolicies {
+				d.StreamListItem(ctx, policy)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getNetworkFirewallFirewallPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	logger.Trace("getNetworkFirewallFirewallPolicy")
+
+	var name, arn string
+	if h.Item != nil {
+		switch item := h.Item.(type) {
+		case *networkfirewall.FirewallPolicyMetadata:
+			name = aws.StringValue(item.Name)
+			arn = aws.StringValue(item.Arn)
+		case *networkfirewall.DescribeFirewallPolicyOutput:
+			return item, nil
+		}
+	} else {
+		name = d.KeyColumnQuals["name"].GetStringValue()
+		arn = d.KeyColumnQuals["arn"].GetStringValue()
+	}
+
+	// Create session
+	svc, err := NetworkFirewallService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the params
+	// Can pass in ARN, name, or both
+	params := &networkfirewall.DescribeFirewallPolicyInput{}
+	if name != "" {
+		params.FirewallPolicyName = aws.String(name)
+	}
+	if arn != "" {
+		params.FirewallPolicyArn = aws.String(arn)
+	}
+
+	// Get call
+	data, err := svc.DescribeFirewallPolicy(params)
+	if err != nil {
+		logger.Debug("getNetworkFirewallFirewallPolicy", "ERROR", err)
+		return nil, err
+	}
+	return data, nil
+}
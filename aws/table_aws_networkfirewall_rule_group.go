@@ -30,13 +30,13 @@ func tableAwsNetworkFirewallRuleGroup(_ context.Context) *plugin.Table {
 				Name:        "rule_group_name",
 				Description: "The descriptive name of the rule group.",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Name"),
+				Transform:   transform.FromField("Name", "RuleGroupResponse.RuleGroupName"),
 			},
 			{
 				Name:        "arn",
 				Description: "The Amazon Resource Name (ARN) of the rule group.",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Arn"),
+				Transform:   transform.FromField("Arn", "RuleGroupResponse.RuleGroupArn"),
 			},
 			{
 				Name:        "capacity",
@@ -121,7 +121,7 @@ func tableAwsNetworkFirewallRuleGroup(_ context.Context) *plugin.Table {
 				Name:        "title",
 				Description: resourceInterfaceDescription("title"),
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Name", "RuleGroupName"),
+				Transform:   transform.FromField("Name", "RuleGroupResponse.RuleGroupName"),
 			},
 			{
 				Name:        "tags",
@@ -134,7 +134,7 @@ func tableAwsNetworkFirewallRuleGroup(_ context.Context) *plugin.Table {
 				Name:        "akas",
 				Description: resourceInterfaceDescription("akas"),
 				Type:        proto.ColumnType_JSON,
-				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+				Transform:   transform.FromField("Arn", "RuleGroupResponse.RuleGroupArn").Transform(transform.EnsureStringArray),
 			},
 		}),
 	}
@@ -193,8 +193,13 @@ func getNetworkFirewallRuleGroup(ctx context.Context, d *plugin.QueryData, h *pl
 
 	var name, arn string
 	if h.Item != nil {
-		name = *h.Item.(*networkfirewall.RuleGroupMetadata).Name
-		arn = *h.Item.(*networkfirewall.RuleGroupMetadata).Arn
+		switch item := h.Item.(type) {
+		case *networkfirewall.RuleGroupMetadata:
+			name = aws.StringValue(item.Name)
+			arn = aws.StringValue(item.Arn)
+		case *networkfirewall.DescribeRuleGroupOutput:
+			return item, nil
+		}
 	} else {
 		name = d.KeyColumnQuals["rule_group_name"].GetStringValue()
 		arn = d.KeyColumnQuals["arn"].GetStringValue()
@@ -2,7 +2,9 @@ package aws
 
 import (
 	"context"
+	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/opensearchservice"
 
 	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
@@ -23,6 +25,9 @@ func tableAwsOpenSearchDomain(_ context.Context) *plugin.Table {
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listOpenSearchDomains,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "engine_type", Require: plugin.Optional},
+			},
 		},
 		HydrateConfig: []plugin.HydrateConfig{
 			{
@@ -56,6 +61,13 @@ func tableAwsOpenSearchDomain(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Hydrate:     getOpenSearchDomain,
 			},
+			{
+				Name:        "policy_std",
+				Description: "Contains the policy in a canonical form for easier searching.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getOpenSearchDomain,
+				Transform:   transform.FromField("AccessPolicies").Transform(unescape).Transform(policyToCanonical),
+			},
 			{
 				Name:        "created",
 				Description: "The domain creation status.",
@@ -80,6 +92,13 @@ func tableAwsOpenSearchDomain(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Hydrate:     getOpenSearchDomain,
 			},
+			{
+				Name:        "engine_type",
+				Description: "The type of search engine of the domain, either OpenSearch or Elasticsearch.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getOpenSearchDomain,
+				Transform:   transform.FromField("EngineVersion").Transform(openSearchDomainEngineType),
+			},
 			{
 				Name:        "processing",
 				Description: "The status of the domain configuration.",
@@ -99,6 +118,34 @@ func tableAwsOpenSearchDomain(_ context.Context) *plugin.Table {
 				Hydrate:     getOpenSearchDomain,
 				Transform:   transform.FromField("NodeToNodeEncryptionOptions.Enabled"),
 			},
+			{
+				Name:        "enforce_https",
+				Description: "Whether only HTTPS endpoint should be enabled for the domain.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getOpenSearchDomain,
+				Transform:   transform.FromField("DomainEndpointOptions.EnforceHTTPS"),
+			},
+			{
+				Name:        "tls_security_policy",
+				Description: "The TLS security policy that needs to be applied to the HTTPS endpoint of the domain.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getOpenSearchDomain,
+				Transform:   transform.FromField("DomainEndpointOptions.TLSSecurityPolicy"),
+			},
+			{
+				Name:        "advanced_security_enabled",
+				Description: "Whether fine-grained access control is enabled for the domain.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getOpenSearchDomain,
+				Transform:   transform.FromField("AdvancedSecurityOptions.Enabled"),
+			},
+			{
+				Name:        "internal_user_database_enabled",
+				Description: "Whether the internal user database of fine-grained access control is enabled for the domain.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getOpenSearchDomain,
+				Transform:   transform.FromField("AdvancedSecurityOptions.InternalUserDatabaseEnabled"),
+			},
 			{
 				Name:        "advanced_options",
 				Description: "Specifies the status of the advanced options.",
@@ -224,6 +271,11 @@ func listOpenSearchDomains(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 	// List call
 	params := &opensearchservice.ListDomainNamesInput{}
 
+	// Additonal Filter
+	if d.KeyColumnQuals["engine_type"] != nil {
+		params.EngineType = aws.String(d.KeyColumnQuals["engine_type"].GetStringValue())
+	}
+
 	op, err := svc.ListDomainNames(params)
 	if err != nil {
 		return nil, err
@@ -334,3 +386,13 @@ func openSearchDomaintagListToTurbotTags(ctx context.Context, d *transform.Trans
 
 	return turbotTagsMap, nil
 }
+
+func openSearchDomainEngineType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	version, ok := d.Value.(*string)
+	if !ok || version == nil {
+		return nil, nil
+	}
+
+	// Engine versions are of the form OpenSearch_1.0 or Elasticsearch_7.10
+	return strings.Split(*version, "_")[0], nil
+}
@@ -27,7 +27,7 @@ func tableAwsOrganizationsAccount(_ context.Context) *plugin.Table {
 		Columns: awsColumns([]*plugin.Column{
 			{
 				Name:        "name",
-				Description: "The description of the permission set.",
+				Description: "The friendly name of the account.",
 				Type:        proto.ColumnType_STRING,
 			},
 			// This description has added text for better clarification on ID type
@@ -61,6 +61,13 @@ func tableAwsOrganizationsAccount(_ context.Context) *plugin.Table {
 				Description: "The date the account became a part of the organization.",
 				Type:        proto.ColumnType_TIMESTAMP,
 			},
+			{
+				Name:        "parent_id",
+				Description: "The unique identifier (ID) of the root or organizational unit (OU) that directly contains the account.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getOrganizationsAccountParent,
+				Transform:   transform.FromField("Id"),
+			},
 			{
 				Name:      "tags_src",
 				Type:      proto.ColumnType_JSON,
@@ -175,6 +182,45 @@ func getOrganizationsAccountTags(ctx context.Context, d *plugin.QueryData, h *pl
 	return tags, err
 }
 
+func getOrganizationsAccountParent(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getOrganizationsAccountParent")
+
+	accountId := *h.Item.(*organizations.Account).Id
+
+	parent, err := getOrganizationsResourceParent(ctx, d, accountId)
+	if err != nil || parent == nil {
+		return nil, err
+	}
+	return parent, nil
+}
+
+// getOrganizationsResourceParent returns the root or OU that directly contains
+// the given account or OU. A child always has exactly one parent.
+func getOrganizationsResourceParent(ctx context.Context, d *plugin.QueryData, childId string) (*organizations.Parent, error) {
+	plugin.Logger(ctx).Trace("getOrganizationsResourceParent")
+
+	// Create Session
+	svc, err := OrganizationService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &organizations.ListParentsInput{
+		ChildId: aws.String(childId),
+	}
+
+	op, err := svc.ListParents(params)
+	if err != nil {
+		plugin.Logger(ctx).Error("getOrganizationsResourceParent", "ListParents_error", err)
+		return nil, err
+	}
+
+	if len(op.Parents) > 0 {
+		return op.Parents[0], nil
+	}
+	return nil, nil
+}
+
 func getOrganizationsResourceTags(ctx context.Context, d *plugin.QueryData, resourceId string) (interface{}, error) {
 	plugin.Logger(ctx).Trace("getOrganizationsResourceTags")
 
@@ -0,0 +1,227 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+type organizationalUnitInfo struct {
+	organizations.OrganizationalUnit
+	ParentId *string
+	Path     string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsOrganizationsOrganizationalUnit(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_organizations_organizational_unit",
+		Description: "AWS Organizations Organizational Unit",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"OrganizationalUnitNotFoundException", "InvalidInputException"}),
+			},
+			Hydrate: getOrganizationsOrganizationalUnit,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listOrganizationsOrganizationalUnits,
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The friendly name of the organizational unit.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier (ID) associated with the organizational unit.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the organizational unit.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "parent_id",
+				Description: "The unique identifier (ID) of the root or organizational unit that directly contains the organizational unit.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "path",
+				Description: "The IDs of the root and every organizational unit from the root down to this organizational unit, separated by a slash, e.g. r-abcd/ou-abcd-11111111/ou-abcd-22222222.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags attached to the organizational unit.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getOrganizationsOrganizationalUnitTags,
+				Transform:   transform.FromValue(),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getOrganizationsOrganizationalUnitTags,
+				Transform:   transform.From(getOrganizationsResourceTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listOrganizationsOrganizationalUnits(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listOrganizationsOrganizationalUnits")
+
+	// Create session
+	svc, err := OrganizationService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Organizational units can only be listed per parent, so walk the tree
+	// starting from each root of the organization
+	var roots []*organizations.Root
+	err = svc.ListRootsPages(
+		&organizations.ListRootsInput{},
+		func(page *organizations.ListRootsOutput, isLast bool) bool {
+			roots = append(roots, page.Roots...)
+			return !isLast
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("listOrganizationsOrganizationalUnits", "ListRootsPages_error", err)
+		return nil, err
+	}
+
+	for _, root := range roots {
+		err = listOrganizationsOrganizationalUnitsForParent(ctx, d, svc, *root.Id, *root.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func listOrganizationsOrganizationalUnitsForParent(ctx context.Context, d *plugin.QueryData, svc *organizations.Organizations, parentId string, parentPath string) error {
+	params := &organizations.ListOrganizationalUnitsForParentInput{
+		ParentId:   aws.String(parentId),
+		MaxResults: aws.Int64(20),
+	}
+
+	var units []*organizations.OrganizationalUnit
+	err := svc.ListOrganizationalUnitsForParentPages(
+		params,
+		func(page *organizations.ListOrganizationalUnitsForParentOutput, isLast bool) bool {
+			units = append(units, page.OrganizationalUnits...)
+			return !isLast
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("listOrganizationsOrganizationalUnitsForParent", "ListOrganizationalUnitsForParentPages_error", err)
+		return err
+	}
+
+	for _, unit := range units {
+		path := parentPath + "/" + *unit.Id
+		d.StreamListItem(ctx, &organizationalUnitInfo{*unit, aws.String(parentId), path})
+
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil
+		}
+
+		err = listOrganizationsOrganizationalUnitsForParent(ctx, d, svc, *unit.Id, path)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getOrganizationsOrganizationalUnit(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getOrganizationsOrganizationalUnit")
+
+	id := d.KeyColumnQuals["id"].GetStringValue()
+
+	// Create session
+	svc, err := OrganizationService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &organizations.DescribeOrganizationalUnitInput{
+		OrganizationalUnitId: aws.String(id),
+	}
+
+	op, err := svc.DescribeOrganizationalUnit(params)
+	if err != nil {
+		plugin.Logger(ctx).Error("getOrganizationsOrganizationalUnit", "DescribeOrganizationalUnit_error", err)
+		return nil, err
+	}
+
+	// Build the path by walking up the tree until the root is reached
+	ids := []string{id}
+	var parentId *string
+	childId := id
+	for {
+		parent, err := getOrganizationsResourceParent(ctx, d, childId)
+		if err != nil {
+			return nil, err
+		}
+		if parent == nil {
+			break
+		}
+		if parentId == nil {
+			parentId = parent.Id
+		}
+		ids = append([]string{*parent.Id}, ids...)
+		if *parent.Type == organizations.ParentTypeRoot {
+			break
+		}
+		childId = *parent.Id
+	}
+
+	return &organizationalUnitInfo{*op.OrganizationalUnit, parentId, strings.Join(ids, "/")}, nil
+}
+
+func getOrganizationsOrganizationalUnitTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getOrganizationsOrganizationalUnitTags")
+
+	resourceId := *h.Item.(*organizationalUnitInfo).Id
+
+	tags, err := getOrganizationsResourceTags(ctx, d, resourceId)
+	return tags, err
+}
@@ -0,0 +1,250 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsOrganizationsPolicy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_organizations_policy",
+		Description: "AWS Organizations Policy",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"PolicyNotFoundException", "InvalidInputException"}),
+			},
+			Hydrate: getOrganizationsPolicy,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listOrganizationsPolicies,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "type", Require: plugin.Optional},
+			},
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The friendly name of the policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier (ID) of the policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of policy, e.g. SERVICE_CONTROL_POLICY, TAG_POLICY, BACKUP_POLICY or AISERVICES_OPT_OUT_POLICY. Defaults to SERVICE_CONTROL_POLICY if not specified in the where clause.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "aws_managed",
+				Description: "A boolean value that indicates whether the specified policy is an Amazon Web Services managed policy.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "content",
+				Description: "The text content of the policy.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getOrganizationsPolicyContent,
+				Transform:   transform.FromField("Content").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "targets",
+				Description: "The roots, organizational units (OUs) and accounts that the policy is attached to.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listOrganizationsPolicyTargets,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags attached to the policy.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getOrganizationsPolicyTags,
+				Transform:   transform.FromValue(),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getOrganizationsPolicyTags,
+				Transform:   transform.From(getOrganizationsResourceTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listOrganizationsPolicies(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listOrganizationsPolicies")
+
+	// Create session
+	svc, err := OrganizationService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// The policy type filter is required by the API
+	policyType := organizations.PolicyTypeServiceControlPolicy
+	if d.KeyColumnQuals["type"] != nil {
+		policyType = d.KeyColumnQuals["type"].GetStringValue()
+	}
+
+	params := &organizations.ListPoliciesInput{
+		Filter:     aws.String(policyType),
+		MaxResults: aws.Int64(20),
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *params.MaxResults {
+			if *limit < 1 {
+				params.MaxResults = aws.Int64(1)
+			} else {
+				params.MaxResults = limit
+			}
+		}
+	}
+
+	err = svc.ListPoliciesPages(
+		params,
+		func(page *organizations.ListPoliciesOutput, isLast bool) bool {
+			for _, policy := range page.Policies {
+				d.StreamListItem(ctx, policy)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("listOrganizationsPolicies", "ListPoliciesPages_error", err)
+	}
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getOrganizationsPolicy(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getOrganizationsPolicy")
+
+	id := d.KeyColumnQuals["id"].GetStringValue()
+
+	op, err := describeOrganizationsPolicy(ctx, d, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return op.PolicySummary, nil
+}
+
+func getOrganizationsPolicyContent(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getOrganizationsPolicyContent")
+
+	id := *h.Item.(*organizations.PolicySummary).Id
+
+	op, err := describeOrganizationsPolicy(ctx, d, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func describeOrganizationsPolicy(ctx context.Context, d *plugin.QueryData, id string) (*organizations.Policy, error) {
+	// Create session
+	svc, err := OrganizationService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &organizations.DescribePolicyInput{
+		PolicyId: aws.String(id),
+	}
+
+	op, err := svc.DescribePolicy(params)
+	if err != nil {
+		plugin.Logger(ctx).Error("describeOrganizationsPolicy", "DescribePolicy_error", err)
+		return nil, err
+	}
+
+	return op.Policy, nil
+}
+
+func listOrganizationsPolicyTargets(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listOrganizationsPolicyTargets")
+
+	id := h.Item.(*organizations.PolicySummary).Id
+
+	// Create session
+	svc, err := OrganizationService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &organizations.ListTargetsForPolicyInput{
+		PolicyId:   id,
+		MaxResults: aws.Int64(20),
+	}
+
+	var targets []*organizations.PolicyTargetSummary
+	err = svc.ListTargetsForPolicyPages(
+		params,
+		func(page *organizations.ListTargetsForPolicyOutput, isLast bool) bool {
+			targets = append(targets, page.Targets...)
+			return !isLast
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("listOrganizationsPolicyTargets", "ListTargetsForPolicyPages_error", err)
+		return nil, err
+	}
+
+	return targets, nil
+}
+
+func getOrganizationsPolicyTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getOrganizationsPolicyTags")
+
+	resourceId := *h.Item.(*organizations.PolicySummary).Id
+
+	tags, err := getOrganizationsResourceTags(ctx, d, resourceId)
+	return tags, err
+}
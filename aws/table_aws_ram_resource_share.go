@@ -0,0 +1,265 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+type resourceShareInfo struct {
+	ram.ResourceShare
+	ResourceOwner *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsRAMResourceShare(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ram_resource_share",
+		Description: "AWS RAM Resource Share",
+		List: &plugin.ListConfig{
+			Hydrate: listRAMResourceShares,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "resource_owner", Require: plugin.Optional},
+				{Name: "name", Require: plugin.Optional},
+				{Name: "arn", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the resource share.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resoure Name (ARN) of the resource share.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceShareArn"),
+			},
+			{
+				Name:        "resource_owner",
+				Description: "Whether the resource share is owned by this account (SELF) or shared with this account by another account (OTHER-ACCOUNTS).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "owning_account_id",
+				Description: "The ID of the Amazon Web Services account that owns the resource share.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the resource share.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_message",
+				Description: "A message about the status of the resource share.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "allow_external_principals",
+				Description: "Indicates whether principals outside your organization in Organizations can be associated with the resource share.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "feature_set",
+				Description: "Indicates how the resource share was created, either CREATED_FROM_POLICY, PROMOTING_TO_STANDARD or STANDARD.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The date and time when the resource share was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_updated_time",
+				Description: "The date and time when the resource share was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "principals",
+				Description: "The principal associations of the resource share, i.e. the accounts, organizations, OUs, roles and users that the resources are shared with.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listRAMResourceSharePrincipalAssociations,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "resources",
+				Description: "The resource associations of the resource share, i.e. the resources that are shared.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listRAMResourceShareResourceAssociations,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags attached to the resource share.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(ramResourceShareTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ResourceShareArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRAMResourceShares(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := RAMService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// The API only returns the shares of one resource owner per call, so both
+	// owners are listed unless the query asks for one of them
+	owners := []string{ram.ResourceOwnerSelf, ram.ResourceOwnerOtherAccounts}
+	equalQuals := d.KeyColumnQuals
+	if equalQuals["resource_owner"] != nil {
+		owner := equalQuals["resource_owner"].GetStringValue()
+		if owner != ram.ResourceOwnerSelf && owner != ram.ResourceOwnerOtherAccounts {
+			return nil, nil
+		}
+		owners = []string{owner}
+	}
+
+	for _, owner := range owners {
+		input := &ram.GetResourceSharesInput{
+			ResourceOwner: aws.String(owner),
+			MaxResults:    aws.Int64(100),
+		}
+
+		// Additonal Filter
+		if equalQuals["name"] != nil {
+			input.Name = aws.String(equalQuals["name"].GetStringValue())
+		}
+		if equalQuals["arn"] != nil {
+			input.ResourceShareArns = []*string{aws.String(equalQuals["arn"].GetStringValue())}
+		}
+		if equalQuals["status"] != nil {
+			input.ResourceShareStatus = aws.String(equalQuals["status"].GetStringValue())
+		}
+
+		// Reduce the basic request limit down if the user has only requested a small number of rows
+		limit := d.QueryContext.Limit
+		if d.QueryContext.Limit != nil {
+			if *limit < *input.MaxResults {
+				if *limit < 1 {
+					input.MaxResults = aws.Int64(1)
+				} else {
+					input.MaxResults = limit
+				}
+			}
+		}
+
+		// List call
+		err = svc.GetResourceSharesPages(
+			input,
+			func(page *ram.GetResourceSharesOutput, isLast bool) bool {
+				for _, share := range page.ResourceShares {
+					d.StreamListItem(ctx, &resourceShareInfo{*share, aws.String(owner)})
+
+					// Context may get cancelled due to manual cancellation or if the limit has been reached
+					if d.QueryStatus.RowsRemaining(ctx) == 0 {
+						return false
+					}
+				}
+				return !isLast
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func listRAMResourceSharePrincipalAssociations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listRAMResourceSharePrincipalAssociations")
+	return getRAMResourceShareAssociations(ctx, d, h, ram.ResourceShareAssociationTypePrincipal)
+}
+
+func listRAMResourceShareResourceAssociations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listRAMResourceShareResourceAssociations")
+	return getRAMResourceShareAssociations(ctx, d, h, ram.ResourceShareAssociationTypeResource)
+}
+
+func getRAMResourceShareAssociations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, associationType string) (interface{}, error) {
+	share := h.Item.(*resourceShareInfo)
+
+	// Create Session
+	svc, err := RAMService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the params
+	input := &ram.GetResourceShareAssociationsInput{
+		AssociationType:   aws.String(associationType),
+		ResourceShareArns: []*string{share.ResourceShareArn},
+		MaxResults:        aws.Int64(100),
+	}
+
+	associations := []*ram.ResourceShareAssociation{}
+	err = svc.GetResourceShareAssociationsPages(
+		input,
+		func(page *ram.GetResourceShareAssociationsOutput, isLast bool) bool {
+			associations = append(associations, page.ResourceShareAssociations...)
+			return !isLast
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return associations, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func ramResourceShareTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.Value.([]*ram.Tag)
+	if !ok || len(tags) == 0 {
+		return nil, nil
+	}
+
+	turbotTagsMap := map[string]string{}
+	for _, i := range tags {
+		turbotTagsMap[*i.Key] = *i.Value
+	}
+
+	return turbotTagsMap, nil
+}
@@ -305,6 +305,11 @@ func tableAwsRDSDBCluster(_ context.Context) *plugin.Table {
 				Description: "A list of VPC security groups that the DB cluster belongs to.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "scaling_configuration_info",
+				Description: "The scaling properties of the DB cluster, for clusters in serverless DB engine mode.",
+				Type:        proto.ColumnType_JSON,
+			},
 			{
 				Name:        "tags_src",
 				Description: "A list of tags attached to the DB Cluster.",
@@ -373,6 +378,11 @@ func listRDSDBClusters(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydra
 		input,
 		func(page *rds.DescribeDBClustersOutput, isLast bool) bool {
 			for _, dbCluster := range page.DBClusters {
+				// The RDS API also returns the Neptune and DocumentDB clusters, which
+				// have their own tables
+				if isNonRDSDBClusterEngine(dbCluster.Engine) {
+					continue
+				}
 				d.StreamListItem(ctx, dbCluster)
 
 				// Check if context has been cancelled or if the limit has been reached (if specified)
@@ -407,12 +417,20 @@ func getRDSDBCluster(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrate
 		return nil, err
 	}
 
-	if op.DBClusters != nil && len(op.DBClusters) > 0 {
+	if op.DBClusters != nil && len(op.DBClusters) > 0 && !isNonRDSDBClusterEngine(op.DBClusters[0].Engine) {
 		return op.DBClusters[0], nil
 	}
 	return nil, nil
 }
 
+func isNonRDSDBClusterEngine(engine *string) bool {
+	switch aws.StringValue(engine) {
+	case "neptune", "docdb":
+		return true
+	}
+	return false
+}
+
 //// TRANSFORM FUNCTIONS
 
 func getRDSDBClusterTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
@@ -0,0 +1,319 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRDSDBProxy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_rds_db_proxy",
+		Description: "AWS RDS DB Proxy",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("db_proxy_name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"DBProxyNotFoundFault"}),
+			},
+			Hydrate: getRDSDBProxy,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRDSDBProxies,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "db_proxy_name",
+				Description: "The identifier for the proxy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBProxyName"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) for the proxy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBProxyArn"),
+			},
+			{
+				Name:        "status",
+				Description: "The current status of this proxy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "engine_family",
+				Description: "The kinds of databases that the proxy can connect to, e.g. MYSQL or POSTGRESQL.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_date",
+				Description: "The date and time when the proxy was first created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "updated_date",
+				Description: "The date and time when the proxy was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "debug_logging",
+				Description: "Whether the proxy includes detailed information about SQL statements in its logs.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "endpoint",
+				Description: "The endpoint that you can use to connect to the DB proxy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "idle_client_timeout",
+				Description: "The number of seconds a connection to the proxy can have no activity before the proxy drops the client connection.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "require_tls",
+				Description: "Indicates whether Transport Layer Security (TLS) encryption is required for connections to the proxy.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("RequireTLS"),
+			},
+			{
+				Name:        "role_arn",
+				Description: "The Amazon Resource Name (ARN) for the IAM role that the proxy uses to access Amazon Secrets Manager.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vpc_id",
+				Description: "The ID of the VPC the proxy is associated with.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "auth",
+				Description: "The authentication mechanisms the proxy uses to connect to the database, including the Secrets Manager secret ARNs and IAM authentication setting.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "target_groups",
+				Description: "The target groups of the proxy, including their connection pool configuration.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getRDSDBProxyTargetGroups,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "targets",
+				Description: "The RDS DB instances and Aurora DB clusters registered with the proxy.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getRDSDBProxyTargets,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "vpc_security_group_ids",
+				Description: "The VPC security group IDs for the proxy.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "vpc_subnet_ids",
+				Description: "The EC2 subnet IDs for the proxy.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags attached to the DB proxy.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getRDSDBProxyTags,
+				Transform:   transform.FromField("TagList"),
+			},
+
+			// Standard columns
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getRDSDBProxyTags,
+				Transform:   transform.From(getRDSDBProxyTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBProxyName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DBProxyArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRDSDBProxies(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listRDSDBProxies")
+
+	// Create Session
+	svc, err := RDSService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &rds.DescribeDBProxiesInput{
+		MaxRecords: aws.Int64(100),
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxRecords {
+			if *limit < 20 {
+				input.MaxRecords = aws.Int64(20)
+			} else {
+				input.MaxRecords = limit
+			}
+		}
+	}
+
+	// List call
+	err = svc.DescribeDBProxiesPages(
+		input,
+		func(page *rds.DescribeDBProxiesOutput, isLast bool) bool {
+			for _, dbProxy := range page.DBProxies {
+				d.StreamListItem(ctx, dbProxy)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRDSDBProxy(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.KeyColumnQuals["db_proxy_name"].GetStringValue()
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := RDSService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &rds.DescribeDBProxiesInput{
+		DBProxyName: aws.String(name),
+	}
+
+	op, err := svc.DescribeDBProxies(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if op.DBProxies != nil && len(op.DBProxies) > 0 {
+		return op.DBProxies[0], nil
+	}
+	return nil, nil
+}
+
+func getRDSDBProxyTargetGroups(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getRDSDBProxyTargetGroups")
+
+	dbProxy := h.Item.(*rds.DBProxy)
+
+	// Create service
+	svc, err := RDSService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*rds.DBProxyTargetGroup
+	err = svc.DescribeDBProxyTargetGroupsPages(
+		&rds.DescribeDBProxyTargetGroupsInput{
+			DBProxyName: dbProxy.DBProxyName,
+		},
+		func(page *rds.DescribeDBProxyTargetGroupsOutput, isLast bool) bool {
+			items = append(items, page.TargetGroups...)
+			return !isLast
+		},
+	)
+
+	return items, err
+}
+
+func getRDSDBProxyTargets(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getRDSDBProxyTargets")
+
+	dbProxy := h.Item.(*rds.DBProxy)
+
+	// Create service
+	svc, err := RDSService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*rds.DBProxyTarget
+	err = svc.DescribeDBProxyTargetsPages(
+		&rds.DescribeDBProxyTargetsInput{
+			DBProxyName: dbProxy.DBProxyName,
+		},
+		func(page *rds.DescribeDBProxyTargetsOutput, isLast bool) bool {
+			items = append(items, page.Targets...)
+			return !isLast
+		},
+	)
+
+	return items, err
+}
+
+func getRDSDBProxyTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getRDSDBProxyTags")
+
+	dbProxy := h.Item.(*rds.DBProxy)
+
+	// Create service
+	svc, err := RDSService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &rds.ListTagsForResourceInput{
+		ResourceName: dbProxy.DBProxyArn,
+	}
+
+	op, err := svc.ListTagsForResource(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getRDSDBProxyTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	dbProxy := d.HydrateItem.(*rds.ListTagsForResourceOutput)
+
+	if dbProxy.TagList != nil {
+		turbotTagsMap := map[string]string{}
+		for _, i := range dbProxy.TagList {
+			turbotTagsMap[*i.Key] = *i.Value
+		}
+		return turbotTagsMap, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,239 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRDSReservedDBInstance(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_rds_reserved_db_instance",
+		Description: "AWS RDS Reserved DB Instance",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("reserved_db_instance_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ReservedDBInstanceNotFound"}),
+			},
+			Hydrate: getRDSReservedDBInstance,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRDSReservedDBInstances,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "class", Require: plugin.Optional},
+				{Name: "duration", Require: plugin.Optional},
+				{Name: "lease_id", Require: plugin.Optional},
+				{Name: "multi_az", Require: plugin.Optional},
+				{Name: "offering_type", Require: plugin.Optional},
+				{Name: "reserved_db_instances_offering_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "reserved_db_instance_id",
+				Description: "The unique identifier for the reservation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) for the reserved DB instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ReservedDBInstanceArn"),
+			},
+			{
+				Name:        "reserved_db_instances_offering_id",
+				Description: "The offering identifier.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the reserved DB instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "class",
+				Description: "The DB instance class for the reserved DB instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBInstanceClass"),
+			},
+			{
+				Name:        "currency_code",
+				Description: "The currency code for the reserved DB instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "db_instance_count",
+				Description: "The number of reserved DB instances.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("DBInstanceCount"),
+			},
+			{
+				Name:        "duration",
+				Description: "The duration of the reservation in seconds.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "fixed_price",
+				Description: "The fixed price charged for this reserved DB instance.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "lease_id",
+				Description: "The unique identifier for the lease associated with the reserved DB instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "multi_az",
+				Description: "Indicates if the reservation applies to Multi-AZ deployments.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("MultiAZ"),
+			},
+			{
+				Name:        "offering_type",
+				Description: "The offering type of this reserved DB instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "product_description",
+				Description: "The description of the reserved DB instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "start_time",
+				Description: "The time the reservation started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "usage_price",
+				Description: "The hourly price charged for this reserved DB instance.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "recurring_charges",
+				Description: "The recurring price charged to run this reserved DB instance.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ReservedDBInstanceId"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ReservedDBInstanceArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRDSReservedDBInstances(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listRDSReservedDBInstances")
+
+	// Create Session
+	svc, err := RDSService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &rds.DescribeReservedDBInstancesInput{
+		MaxRecords: aws.Int64(100),
+	}
+
+	// Additional filters
+	equalQuals := d.KeyColumnQuals
+	if equalQuals["class"] != nil {
+		input.DBInstanceClass = aws.String(equalQuals["class"].GetStringValue())
+	}
+	if equalQuals["duration"] != nil {
+		input.Duration = aws.String(fmt.Sprint(equalQuals["duration"].GetInt64Value()))
+	}
+	if equalQuals["lease_id"] != nil {
+		input.LeaseId = aws.String(equalQuals["lease_id"].GetStringValue())
+	}
+	if equalQuals["offering_type"] != nil {
+		input.OfferingType = aws.String(equalQuals["offering_type"].GetStringValue())
+	}
+	if equalQuals["reserved_db_instances_offering_id"] != nil {
+		input.ReservedDBInstancesOfferingId = aws.String(equalQuals["reserved_db_instances_offering_id"].GetStringValue())
+	}
+	if equalQuals["multi_az"] != nil {
+		input.MultiAZ = aws.Bool(equalQuals["multi_az"].GetBoolValue())
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxRecords {
+			if *limit < 20 {
+				input.MaxRecords = aws.Int64(20)
+			} else {
+				input.MaxRecords = limit
+			}
+		}
+	}
+
+	// List call
+	err = svc.DescribeReservedDBInstancesPages(
+		input,
+		func(page *rds.DescribeReservedDBInstancesOutput, isLast bool) bool {
+			for _, reservedDBInstance := range page.ReservedDBInstances {
+				d.StreamListItem(ctx, reservedDBInstance)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRDSReservedDBInstance(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	reservedDBInstanceId := d.KeyColumnQuals["reserved_db_instance_id"].GetStringValue()
+
+	// Empty check
+	if reservedDBInstanceId == "" {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := RDSService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &rds.DescribeReservedDBInstancesInput{
+		ReservedDBInstanceId: aws.String(reservedDBInstanceId),
+	}
+
+	op, err := svc.DescribeReservedDBInstances(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if op.ReservedDBInstances != nil && len(op.ReservedDBInstances) > 0 {
+		return op.ReservedDBInstances[0], nil
+	}
+	return nil, nil
+}
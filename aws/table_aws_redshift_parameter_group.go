@@ -50,6 +50,7 @@ func tableAwsRedshiftParameterGroup(_ context.Context) *plugin.Table {
 				Description: "A list of Parameter instances. Each instance lists the parameters of one cluster parameter group.",
 				Type:        proto.ColumnType_JSON,
 				Hydrate:     getAwsRedshiftParameters,
+				Transform:   transform.FromValue(),
 			},
 			{
 				Name:        "tags_src",
@@ -176,13 +177,20 @@ func getAwsRedshiftParameters(ctx context.Context, d *plugin.QueryData, h *plugi
 		ParameterGroupName: name,
 	}
 
-	// Get call
-	op, err := svc.DescribeClusterParameters(params)
+	// List call
+	var parameters []*redshift.Parameter
+	err = svc.DescribeClusterParametersPages(
+		params,
+		func(page *redshift.DescribeClusterParametersOutput, isLast bool) bool {
+			parameters = append(parameters, page.Parameters...)
+			return !isLast
+		},
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	return op, nil
+	return parameters, nil
 }
 
 func getAwsRedshiftParameterGroupAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
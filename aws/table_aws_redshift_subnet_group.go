@@ -58,7 +58,6 @@ func tableAwsRedshiftSubnetGroup(_ context.Context) *plugin.Table {
 				Name:        "tags_src",
 				Description: "A list of tags attached to the subnet group.",
 				Type:        proto.ColumnType_JSON,
-				Hydrate:     getRedshiftSubnetGroup,
 				Transform:   transform.FromField("Tags"),
 			},
 
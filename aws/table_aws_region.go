@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/turbot/go-kit/types"
 
 	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
@@ -39,6 +40,17 @@ func tableAwsRegion(_ context.Context) *plugin.Table {
 				Description: "The Region opt-in status. The possible values are opt-in-not-required, opted-in, and not-opted-in",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "opt_in_not_required",
+				Description: "Indicates whether the Region is enabled by default, i.e. does not require opting in.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("OptInStatus").Transform(regionOptInNotRequired),
+			},
+			{
+				Name:        "endpoint",
+				Description: "The Region service endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
 			{
 				Name:        "title",
 				Description: resourceInterfaceDescription("title"),
@@ -152,3 +164,7 @@ func getAwsRegionAkas(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 	akas := []string{"arn:" + commonColumnData.Partition + "::" + *region.RegionName + ":" + commonColumnData.AccountId}
 	return akas, nil
 }
+
+func regionOptInNotRequired(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	return types.SafeString(d.Value) == "opt-in-not-required", nil
+}
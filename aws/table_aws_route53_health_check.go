@@ -55,6 +55,42 @@ func tableAwsRoute53HealthCheck(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("LinkedService.Description"),
 			},
+			{
+				Name:        "type",
+				Description: "The type of health check, e.g. HTTP, HTTPS, HTTP_STR_MATCH, HTTPS_STR_MATCH, TCP, CALCULATED, CLOUDWATCH_METRIC or RECOVERY_CONTROL.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HealthCheckConfig.Type"),
+			},
+			{
+				Name:        "ip_address",
+				Description: "The IPv4 or IPv6 IP address of the endpoint that Route 53 performs health checks on.",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("HealthCheckConfig.IPAddress"),
+			},
+			{
+				Name:        "fully_qualified_domain_name",
+				Description: "The fully qualified domain name of the endpoint that Route 53 performs health checks on.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HealthCheckConfig.FullyQualifiedDomainName"),
+			},
+			{
+				Name:        "port",
+				Description: "The port on the endpoint that Route 53 performs health checks on.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("HealthCheckConfig.Port"),
+			},
+			{
+				Name:        "resource_path",
+				Description: "The path that Route 53 requests when performing HTTP and HTTPS health checks.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HealthCheckConfig.ResourcePath"),
+			},
+			{
+				Name:        "failure_threshold",
+				Description: "The number of consecutive health checks that an endpoint must pass or fail for Route 53 to change its current status.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("HealthCheckConfig.FailureThreshold"),
+			},
 			{
 				Name:        "cloud_watch_alarm_configuration",
 				Description: "A complex type that contains information about the CloudWatch alarm that Amazon Route 53 is monitoring for this health check.",
@@ -2,15 +2,28 @@ package aws
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
 )
 
+// maxS3BucketLocationConcurrency bounds the number of bucket locations that
+// are resolved concurrently when the list is filtered by region
+const maxS3BucketLocationConcurrency = 10
+
+// s3BucketHydrateMaxConcurrency bounds the number of concurrent calls of each
+// bucket hydrate within a query, on top of the connection wide max_concurrency
+const s3BucketHydrateMaxConcurrency = 25
+
 func tableAwsS3Bucket(_ context.Context) *plugin.Table {
 	return &plugin.Table{
 		Name:        "aws_s3_bucket",
@@ -21,55 +34,79 @@ func tableAwsS3Bucket(_ context.Context) *plugin.Table {
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listS3Buckets,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "region", Require: plugin.Optional},
+			},
 		},
 		HydrateConfig: []plugin.HydrateConfig{
 			{
-				Func:    getBucketIsPublic,
-				Depends: []plugin.HydrateFunc{getBucketLocation},
+				Func:           getBucketLocation,
+				MaxConcurrency: s3BucketHydrateMaxConcurrency,
+			},
+			{
+				Func:           getBucketIsPublic,
+				Depends:        []plugin.HydrateFunc{getBucketLocation},
+				MaxConcurrency: s3BucketHydrateMaxConcurrency,
+			},
+			{
+				Func:           getBucketVersioning,
+				Depends:        []plugin.HydrateFunc{getBucketLocation},
+				MaxConcurrency: s3BucketHydrateMaxConcurrency,
 			},
 			{
-				Func:    getBucketVersioning,
-				Depends: []plugin.HydrateFunc{getBucketLocation},
+				Func:           getBucketEncryption,
+				Depends:        []plugin.HydrateFunc{getBucketLocation},
+				MaxConcurrency: s3BucketHydrateMaxConcurrency,
 			},
 			{
-				Func:    getBucketEncryption,
-				Depends: []plugin.HydrateFunc{getBucketLocation},
+				Func:           getBucketPublicAccessBlock,
+				Depends:        []plugin.HydrateFunc{getBucketLocation},
+				MaxConcurrency: s3BucketHydrateMaxConcurrency,
 			},
 			{
-				Func:    getBucketPublicAccessBlock,
-				Depends: []plugin.HydrateFunc{getBucketLocation},
+				Func:           getBucketACL,
+				Depends:        []plugin.HydrateFunc{getBucketLocation},
+				MaxConcurrency: s3BucketHydrateMaxConcurrency,
 			},
 			{
-				Func:    getBucketACL,
-				Depends: []plugin.HydrateFunc{getBucketLocation},
+				Func:           getBucketLifecycle,
+				Depends:        []plugin.HydrateFunc{getBucketLocation},
+				MaxConcurrency: s3BucketHydrateMaxConcurrency,
 			},
 			{
-				Func:    getBucketLifecycle,
-				Depends: []plugin.HydrateFunc{getBucketLocation},
+				Func:           getBucketCors,
+				Depends:        []plugin.HydrateFunc{getBucketLocation},
+				MaxConcurrency: s3BucketHydrateMaxConcurrency,
 			},
 			{
-				Func:    getBucketLogging,
-				Depends: []plugin.HydrateFunc{getBucketLocation},
+				Func:           getBucketLogging,
+				Depends:        []plugin.HydrateFunc{getBucketLocation},
+				MaxConcurrency: s3BucketHydrateMaxConcurrency,
 			},
 			{
-				Func:    getBucketPolicy,
-				Depends: []plugin.HydrateFunc{getBucketLocation},
+				Func:           getBucketPolicy,
+				Depends:        []plugin.HydrateFunc{getBucketLocation},
+				MaxConcurrency: s3BucketHydrateMaxConcurrency,
 			},
 			{
-				Func:    getBucketReplication,
-				Depends: []plugin.HydrateFunc{getBucketLocation},
+				Func:           getBucketReplication,
+				Depends:        []plugin.HydrateFunc{getBucketLocation},
+				MaxConcurrency: s3BucketHydrateMaxConcurrency,
 			},
 			{
-				Func:    getBucketTagging,
-				Depends: []plugin.HydrateFunc{getBucketLocation},
+				Func:           getBucketTagging,
+				Depends:        []plugin.HydrateFunc{getBucketLocation},
+				MaxConcurrency: s3BucketHydrateMaxConcurrency,
 			},
 			{
-				Func:    getObjectLockConfiguration,
-				Depends: []plugin.HydrateFunc{getBucketLocation},
+				Func:           getObjectLockConfiguration,
+				Depends:        []plugin.HydrateFunc{getBucketLocation},
+				MaxConcurrency: s3BucketHydrateMaxConcurrency,
 			},
 			{
-				Func:    getS3BucketEventNotificationConfigurations,
-				Depends: []plugin.HydrateFunc{getBucketLocation},
+				Func:           getS3BucketEventNotificationConfigurations,
+				Depends:        []plugin.HydrateFunc{getBucketLocation},
+				MaxConcurrency: s3BucketHydrateMaxConcurrency,
 			},
 		},
 		Columns: awsS3Columns([]*plugin.Column{
@@ -89,6 +126,8 @@ func tableAwsS3Bucket(_ context.Context) *plugin.Table {
 				Name:        "creation_date",
 				Description: "The date and tiem when bucket was created.",
 				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getS3BucketCreationDate,
+				Transform:   transform.FromValue(),
 			},
 			{
 				Name:        "bucket_policy_is_public",
@@ -168,6 +207,13 @@ func tableAwsS3Bucket(_ context.Context) *plugin.Table {
 				Hydrate:     getBucketLifecycle,
 				Transform:   transform.FromField("Rules"),
 			},
+			{
+				Name:        "cors_rules",
+				Description: "The Cross-Origin Resource Sharing (CORS) configuration information set for the bucket.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBucketCors,
+				Transform:   transform.FromField("CORSRules"),
+			},
 			{
 				Name:        "logging",
 				Description: "The logging status of a bucket and the permissions users have to view and modify that status.",
@@ -254,11 +300,18 @@ func listS3Buckets(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDa
 
 	// execute list call
 	input := &s3.ListBucketsInput{}
-	bucketsResult, err := svc.ListBuckets(input)
+	bucketsResult, err := svc.ListBucketsWithContext(ctx, input)
 	if err != nil {
 		return nil, err
 	}
 
+	// Buckets outside the requested region are skipped here, rather than
+	// running every hydrate for them only to be filtered out afterwards
+	region := d.KeyColumnQualString("region")
+	if region != "" {
+		return nil, streamS3BucketsInRegion(ctx, d, bucketsResult.Buckets, region)
+	}
+
 	for _, bucket := range bucketsResult.Buckets {
 		d.StreamListItem(ctx, bucket)
 
@@ -271,35 +324,178 @@ func listS3Buckets(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDa
 	return nil, err
 }
 
+// streamS3BucketsInRegion streams the buckets located in the given region. The
+// locations are resolved concurrently, so that accounts with thousands of
+// buckets do not wait on thousands of sequential calls on a cold cache.
+func streamS3BucketsInRegion(ctx context.Context, d *plugin.QueryData, buckets []*s3.Bucket, region string) error {
+	// Buckets deleted since the list call, or whose location can't be read
+	// because access is denied, are skipped rather than failing the query
+	shouldSkip := isNotFoundError([]string{"NoSuchBucket", "AccessDenied"})
+
+	var wg sync.WaitGroup
+	errorCh := make(chan error, len(buckets))
+	sem := make(chan struct{}, maxS3BucketLocationConcurrency)
+	for _, bucket := range buckets {
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.QueryStatus.RowsRemaining(ctx) == 0 || len(errorCh) > 0 {
+			break
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(bucket *s3.Bucket) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			location, err := getS3BucketLocationByName(ctx, d, *bucket.Name)
+			if err != nil {
+				if shouldSkip(ctx, d, nil, err) {
+					plugin.Logger(ctx).Debug("listS3Buckets", "bucket", *bucket.Name, "skipping", err)
+					return
+				}
+				errorCh <- err
+				return
+			}
+			if *location.LocationConstraint == region {
+				d.StreamListItem(ctx, bucket)
+			}
+		}(bucket)
+	}
+
+	// wait for all bucket locations to be resolved
+	wg.Wait()
+	close(errorCh)
+
+	for err := range errorCh {
+		// return the first error
+		plugin.Logger(ctx).Error("listS3Buckets", "get_bucket_location_error", err)
+		return err
+	}
+
+	return nil
+}
+
 //// HYDRATE FUNCTIONS
 
-// do not have a get call for s3 bucket.
-// using list api call to create get function
-func getS3Bucket(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("listS3Buckets")
+// there is no get call for s3 bucket, so HeadBucket is used to confirm the
+// bucket exists and is owned by the account, and the item is synthesized
+func getS3Bucket(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getS3Bucket")
 	defaultRegion := GetDefaultAwsRegion(d)
 	name := d.KeyColumnQuals["name"].GetStringValue()
 
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	c, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := c.(*awsCommonColumnData)
+
 	// Create Session
 	svc, err := S3Service(ctx, d, defaultRegion)
 	if err != nil {
 		return nil, err
 	}
 
-	// execute list call
-	input := &s3.ListBucketsInput{}
-	bucketsResult, err := svc.ListBuckets(input)
+	// Buckets owned by other accounts are rejected by S3, since the list call
+	// only ever returns buckets owned by the account
+	region, err := headS3Bucket(ctx, svc, &s3.HeadBucketInput{
+		Bucket:              aws.String(name),
+		ExpectedBucketOwner: aws.String(commonColumnData.AccountId),
+	})
 	if err != nil {
+		// S3 answers 403 when the bucket is owned by another account, which is
+		// treated the same as a bucket that doesn't exist
+		if a, ok := err.(awserr.RequestFailure); ok {
+			if a.StatusCode() == http.StatusNotFound || a.StatusCode() == http.StatusForbidden {
+				return nil, nil
+			}
+		}
 		return nil, err
 	}
 
-	for _, item := range bucketsResult.Buckets {
-		if *item.Name == name {
-			return item, nil
+	// The bucket region is known at this point, so save the location lookup
+	if region != "" {
+		setS3BucketLocationCache(d, name, &s3.GetBucketLocationOutput{
+			LocationConstraint: aws.String(region),
+		})
+	}
+
+	return &s3.Bucket{
+		Name: aws.String(name),
+	}, nil
+}
+
+// headS3Bucket calls HeadBucket and returns the bucket region reported in the
+// response headers. Buckets in other regions respond with a 301 carrying the
+// bucket region header, which still confirms the bucket exists.
+func headS3Bucket(ctx context.Context, svc *s3.S3, input *s3.HeadBucketInput) (string, error) {
+	req, _ := svc.HeadBucketRequest(input)
+	req.SetContext(ctx)
+	req.DisableFollowRedirects = true
+
+	var region string
+	req.Handlers.Send.PushBack(func(r *request.Request) {
+		if r.HTTPResponse == nil {
+			return
+		}
+		region = r.HTTPResponse.Header.Get("X-Amz-Bucket-Region")
+		if r.HTTPResponse.StatusCode == http.StatusMovedPermanently && region != "" {
+			r.HTTPResponse.StatusCode = http.StatusOK
+			r.HTTPResponse.Status = "OK"
+			r.Error = nil
 		}
+	})
+
+	if err := req.Send(); err != nil {
+		return "", err
 	}
 
-	return nil, err
+	return s3.NormalizeBucketLocation(region), nil
+}
+
+// getS3BucketCreationDate returns the creation date of the bucket. Items
+// returned by the get call do not carry it, so it is looked up from the
+// cached bucket list instead.
+func getS3BucketCreationDate(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getS3BucketCreationDate")
+	bucket := h.Item.(*s3.Bucket)
+
+	if bucket.CreationDate != nil {
+		return bucket.CreationDate, nil
+	}
+
+	listS3BucketsOutputCached := plugin.HydrateFunc(listS3BucketsOutput).WithCache()
+	data, err := listS3BucketsOutputCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range data.(*s3.ListBucketsOutput).Buckets {
+		if *item.Name == *bucket.Name {
+			return item.CreationDate, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func listS3BucketsOutput(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listS3BucketsOutput")
+	defaultRegion := GetDefaultAwsRegion(d)
+
+	// Create Session
+	svc, err := S3Service(ctx, d, defaultRegion)
+	if err != nil {
+		return nil, err
+	}
+
+	return svc.ListBucketsWithContext(ctx, &s3.ListBucketsInput{})
 }
 
 func getS3BucketEventNotificationConfigurations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
@@ -325,7 +521,7 @@ func getS3BucketEventNotificationConfigurations(ctx context.Context, d *plugin.Q
 		Bucket: name,
 	}
 
-	notificatiionDetails, err := svc.GetBucketNotificationConfiguration(input)
+	notificatiionDetails, err := svc.GetBucketNotificationConfigurationWithContext(ctx, input)
 	if err != nil {
 		plugin.Logger(ctx).Error("getS3BucketEventNotificationConfigurations", "GetBucketNotification", err)
 		return nil, err
@@ -336,6 +532,19 @@ func getS3BucketEventNotificationConfigurations(ctx context.Context, d *plugin.Q
 func getBucketLocation(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	plugin.Logger(ctx).Trace("getBucketLocation")
 	bucket := h.Item.(*s3.Bucket)
+
+	return getS3BucketLocationByName(ctx, d, *bucket.Name)
+}
+
+// getS3BucketLocationByName returns the location of the named bucket,
+// normalizing the legacy "EU" and null location constraints into region names.
+// Locations rarely change, so they are cached across hydrate calls and queries.
+func getS3BucketLocationByName(ctx context.Context, d *plugin.QueryData, name string) (*s3.GetBucketLocationOutput, error) {
+	cacheKey := fmt.Sprintf("s3-bucket-location-%s", name)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(*s3.GetBucketLocationOutput), nil
+	}
+
 	defaultRegion := GetDefaultAwsRegion(d)
 
 	// Create Session
@@ -345,13 +554,27 @@ func getBucketLocation(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 	}
 
 	params := &s3.GetBucketLocationInput{
-		Bucket: bucket.Name,
+		Bucket: aws.String(name),
 	}
 
 	// Specifies the Region where the bucket resides. For a list of all the Amazon
 	// S3 supported location constraints by Region, see Regions and Endpoints (https://docs.aws.amazon.com/general/latest/gr/rande.html#s3_region).
-	location, err := svc.GetBucketLocation(params)
+	location, err := svc.GetBucketLocationWithContext(ctx, params)
 	if err != nil {
+		// Many organizations deny s3:GetBucketLocation through policies while
+		// still allowing HeadBucket, which also reports the bucket region
+		if a, ok := err.(awserr.Error); ok && a.Code() == "AccessDenied" {
+			plugin.Logger(ctx).Debug("getS3BucketLocationByName", "bucket", name, "falling back to HeadBucket", err)
+			region, headErr := headS3Bucket(ctx, svc, &s3.HeadBucketInput{Bucket: aws.String(name)})
+			if headErr != nil || region == "" {
+				return nil, err
+			}
+			location = &s3.GetBucketLocationOutput{
+				LocationConstraint: aws.String(region),
+			}
+			setS3BucketLocationCache(d, name, location)
+			return location, nil
+		}
 		return nil, err
 	}
 
@@ -359,17 +582,35 @@ func getBucketLocation(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 		// Buckets in eu-west-1 created through the AWS CLI or other API driven methods can return a location of "EU",
 		// so we need to convert back
 		if *location.LocationConstraint == "EU" {
-			return &s3.GetBucketLocationOutput{
+			location = &s3.GetBucketLocationOutput{
 				LocationConstraint: aws.String("eu-west-1"),
-			}, nil
+			}
+		}
+	} else {
+		// Buckets in us-east-1 have a LocationConstraint of null
+		location = &s3.GetBucketLocationOutput{
+			LocationConstraint: aws.String("us-east-1"),
 		}
-		return location, nil
 	}
 
-	// Buckets in us-east-1 have a LocationConstraint of null
-	return &s3.GetBucketLocationOutput{
-		LocationConstraint: aws.String("us-east-1"),
-	}, nil
+	setS3BucketLocationCache(d, name, location)
+	return location, nil
+}
+
+// setS3BucketLocationCache caches the location of the named bucket, for the
+// number of seconds set in the s3_bucket_location_ttl config argument
+func setS3BucketLocationCache(d *plugin.QueryData, name string, location *s3.GetBucketLocationOutput) {
+	ttl := 1 * time.Hour
+	awsConfig := GetConfig(d.Connection)
+	if awsConfig.S3BucketLocationTTL != nil {
+		ttl = time.Duration(*awsConfig.S3BucketLocationTTL) * time.Second
+	}
+
+	// A TTL of 0 disables caching
+	if ttl <= 0 {
+		return
+	}
+	d.ConnectionManager.Cache.SetWithTTL(fmt.Sprintf("s3-bucket-location-%s", name), location, ttl)
 }
 
 func getBucketIsPublic(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
@@ -394,7 +635,7 @@ func getBucketIsPublic(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 		Bucket: bucket.Name,
 	}
 
-	policyStatus, err := svc.GetBucketPolicyStatus(params)
+	policyStatus, err := svc.GetBucketPolicyStatusWithContext(ctx, params)
 
 	if err != nil {
 		if a, ok := err.(awserr.Error); ok {
@@ -430,7 +671,7 @@ func getBucketVersioning(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 		Bucket: bucket.Name,
 	}
 
-	versioning, err := svc.GetBucketVersioning(params)
+	versioning, err := svc.GetBucketVersioningWithContext(ctx, params)
 	if err != nil {
 		return nil, err
 	}
@@ -459,7 +700,7 @@ func getBucketEncryption(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 		Bucket: bucket.Name,
 	}
 
-	encryption, err := svc.GetBucketEncryption(params)
+	encryption, err := svc.GetBucketEncryptionWithContext(ctx, params)
 	if err != nil {
 		if a, ok := err.(awserr.Error); ok {
 			if a.Code() == "ServerSideEncryptionConfigurationNotFoundError" {
@@ -501,7 +742,7 @@ func getBucketPublicAccessBlock(ctx context.Context, d *plugin.QueryData, h *plu
 		RestrictPublicBuckets: aws.Bool(false),
 	}
 
-	accessBlock, err := svc.GetPublicAccessBlock(params)
+	accessBlock, err := svc.GetPublicAccessBlockWithContext(ctx, params)
 	if err != nil {
 		// If the GetPublicAccessBlock is called on buckets which were created before Public Access Block setting was
 		// introduced, sometime it fails with error NoSuchPublicAccessBlockConfiguration
@@ -538,7 +779,7 @@ func getBucketACL(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDat
 		Bucket: bucket.Name,
 	}
 
-	acl, err := svc.GetBucketAcl(params)
+	acl, err := svc.GetBucketAclWithContext(ctx, params)
 	if err != nil {
 		return nil, err
 	}
@@ -568,7 +809,7 @@ func getBucketLifecycle(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 		Bucket: bucket.Name,
 	}
 
-	lifecycleConfiguration, err := svc.GetBucketLifecycleConfiguration(params)
+	lifecycleConfiguration, err := svc.GetBucketLifecycleConfigurationWithContext(ctx, params)
 	if err != nil {
 		if a, ok := err.(awserr.Error); ok {
 			if a.Code() == "NoSuchLifecycleConfiguration" {
@@ -581,6 +822,41 @@ func getBucketLifecycle(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 	return lifecycleConfiguration, nil
 }
 
+func getBucketCors(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getBucketCors")
+
+	// Bucket location will be nil if getBucketLocation returned an error but
+	// was ignored through ignore_error_codes config arg
+	if h.HydrateResults["getBucketLocation"] == nil {
+		return nil, nil
+	}
+
+	bucket := h.Item.(*s3.Bucket)
+	location := h.HydrateResults["getBucketLocation"].(*s3.GetBucketLocationOutput)
+
+	// Create Session
+	svc, err := S3Service(ctx, d, *location.LocationConstraint)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &s3.GetBucketCorsInput{
+		Bucket: bucket.Name,
+	}
+
+	corsConfiguration, err := svc.GetBucketCorsWithContext(ctx, params)
+	if err != nil {
+		if a, ok := err.(awserr.Error); ok {
+			if a.Code() == "NoSuchCORSConfiguration" {
+				return nil, nil
+			}
+		}
+		return nil, err
+	}
+
+	return corsConfiguration, nil
+}
+
 func getBucketLogging(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	plugin.Logger(ctx).Trace("getBucketLogging")
 
@@ -603,7 +879,7 @@ func getBucketLogging(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 		Bucket: bucket.Name,
 	}
 
-	logging, err := svc.GetBucketLogging(params)
+	logging, err := svc.GetBucketLoggingWithContext(ctx, params)
 	if err != nil {
 		return nil, err
 	}
@@ -631,7 +907,7 @@ func getBucketPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 		Bucket: bucket.Name,
 	}
 
-	bucketPolicy, err := svc.GetBucketPolicy(params)
+	bucketPolicy, err := svc.GetBucketPolicyWithContext(ctx, params)
 	if err != nil {
 		if a, ok := err.(awserr.Error); ok {
 			if a.Code() == "NoSuchBucketPolicy" {
@@ -665,7 +941,7 @@ func getBucketReplication(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 		Bucket: bucket.Name,
 	}
 
-	replication, err := svc.GetBucketReplication(params)
+	replication, err := svc.GetBucketReplicationWithContext(ctx, params)
 	if err != nil {
 		if a, ok := err.(awserr.Error); ok {
 			if a.Code() == "ReplicationConfigurationNotFoundError" {
@@ -700,8 +976,18 @@ func getBucketTagging(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 		Bucket: bucket.Name,
 	}
 
-	bucketTags, _ := svc.GetBucketTagging(params)
+	return bucketTaggingResult(svc.GetBucketTaggingWithContext(ctx, params))
+}
+
+// bucketTaggingResult maps the GetBucketTagging response, treating buckets
+// without tags (NoSuchTagSet) as having an empty tag set
+func bucketTaggingResult(bucketTags *s3.GetBucketTaggingOutput, err error) (*s3.GetBucketTaggingOutput, error) {
 	if err != nil {
+		if a, ok := err.(awserr.Error); ok {
+			if a.Code() == "NoSuchTagSet" {
+				return &s3.GetBucketTaggingOutput{TagSet: []*s3.Tag{}}, nil
+			}
+		}
 		return nil, err
 	}
 
@@ -746,7 +1032,7 @@ func getObjectLockConfiguration(ctx context.Context, d *plugin.QueryData, h *plu
 		Bucket: bucket.Name,
 	}
 
-	data, err := svc.GetObjectLockConfiguration(params)
+	data, err := svc.GetObjectLockConfigurationWithContext(ctx, params)
 	if err != nil {
 		if a, ok := err.(awserr.Error); ok {
 			if a.Code() == "ObjectLockConfigurationNotFoundError" {
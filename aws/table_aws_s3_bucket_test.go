@@ -0,0 +1,44 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+func TestBucketTaggingResult(t *testing.T) {
+	tagged := &s3.GetBucketTaggingOutput{
+		TagSet: []*s3.Tag{{Key: aws.String("env"), Value: aws.String("prod")}},
+	}
+
+	// Tags are returned unchanged when the call succeeds
+	result, err := bucketTaggingResult(tagged, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result != tagged {
+		t.Errorf("expected the API output to be returned unchanged, got %v", result)
+	}
+
+	// Buckets without tags return an empty tag set rather than an error
+	result, err = bucketTaggingResult(nil, awserr.New("NoSuchTagSet", "The TagSet does not exist", nil))
+	if err != nil {
+		t.Fatalf("expected NoSuchTagSet to be mapped to an empty tag set, got error: %v", err)
+	}
+	if result == nil || result.TagSet == nil || len(result.TagSet) != 0 {
+		t.Errorf("expected an empty tag set, got %v", result)
+	}
+
+	// Any other API error must be surfaced
+	for _, code := range []string{"AccessDenied", "SlowDown"} {
+		result, err = bucketTaggingResult(nil, awserr.New(code, "failed", nil))
+		if err == nil {
+			t.Errorf("expected %s error to be returned, got result %v", code, result)
+		}
+		if a, ok := err.(awserr.Error); !ok || a.Code() != code {
+			t.Errorf("expected %s error to be returned unchanged, got %v", code, err)
+		}
+	}
+}
@@ -0,0 +1,301 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+type s3ObjectInfo struct {
+	s3.Object
+	BucketName *string
+	Region     *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsS3Object(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_s3_object",
+		Description: "AWS S3 Object",
+		List: &plugin.ListConfig{
+			Hydrate: listS3Objects,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "bucket_name", Require: plugin.Required},
+				{Name: "prefix", Require: plugin.Optional},
+				{Name: "key", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"NoSuchBucket"}),
+			},
+		},
+		Columns: awsS3Columns([]*plugin.Column{
+			{
+				Name:        "key",
+				Description: "The name that you assign to an object.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "bucket_name",
+				Description: "The name of the bucket containing the object.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "prefix",
+				Description: "The prefix of the key used to filter the objects.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("prefix"),
+			},
+			{
+				Name:        "size",
+				Description: "Size in bytes of the object.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "storage_class",
+				Description: "The class of storage used to store the object.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_modified",
+				Description: "Creation date of the object.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "etag",
+				Description: "The entity tag is a hash of the object. The ETag reflects changes only to the contents of an object, not its metadata.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ETag"),
+			},
+			{
+				Name:        "owner",
+				Description: "The owner of the object.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "acl",
+				Description: "The access control list (ACL) of the object.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3ObjectACL,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "object_lock_retention",
+				Description: "The retention settings for the object.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3ObjectRetention,
+				Transform:   transform.FromField("Retention"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the object.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3ObjectTagging,
+				Transform:   transform.FromField("TagSet"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3ObjectTagging,
+				Transform:   transform.FromField("TagSet").Transform(s3TagsToTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Key"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3ObjectARN,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+			{
+				Name:        "region",
+				Description: "The AWS Region in which the resource is located.",
+				Type:        proto.ColumnType_STRING,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listS3Objects(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listS3Objects")
+
+	bucketName := d.KeyColumnQuals["bucket_name"].GetStringValue()
+	if bucketName == "" {
+		return nil, nil
+	}
+
+	// Objects can only be listed from the region the bucket resides in
+	location, err := getS3BucketLocationByName(ctx, d, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	region := *location.LocationConstraint
+
+	// Create Session
+	svc, err := S3Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &s3.ListObjectsV2Input{
+		Bucket:  aws.String(bucketName),
+		MaxKeys: aws.Int64(1000),
+	}
+
+	// An exact key match is served by listing with the key as prefix and
+	// discarding the longer keys sharing it
+	key := d.KeyColumnQuals["key"].GetStringValue()
+	if d.KeyColumnQuals["prefix"] != nil {
+		input.Prefix = aws.String(d.KeyColumnQuals["prefix"].GetStringValue())
+	}
+	if key != "" {
+		input.Prefix = aws.String(key)
+	}
+
+	// Limiting the results
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil && key == "" {
+		if *limit < *input.MaxKeys {
+			if *limit < 1 {
+				input.MaxKeys = aws.Int64(1)
+			} else {
+				input.MaxKeys = limit
+			}
+		}
+	}
+
+	err = svc.ListObjectsV2PagesWithContext(
+		ctx,
+		input,
+		func(page *s3.ListObjectsV2Output, isLast bool) bool {
+			for _, object := range page.Contents {
+				if key != "" && *object.Key != key {
+					continue
+				}
+				d.StreamListItem(ctx, &s3ObjectInfo{
+					Object:     *object,
+					BucketName: aws.String(bucketName),
+					Region:     aws.String(region),
+				})
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getS3ObjectACL(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getS3ObjectACL")
+	object := h.Item.(*s3ObjectInfo)
+
+	// Create Session
+	svc, err := S3Service(ctx, d, *object.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &s3.GetObjectAclInput{
+		Bucket: object.BucketName,
+		Key:    object.Key,
+	}
+
+	acl, err := svc.GetObjectAclWithContext(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("getS3ObjectACL", "api_error", err)
+		return nil, err
+	}
+
+	return acl, nil
+}
+
+func getS3ObjectRetention(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getS3ObjectRetention")
+	object := h.Item.(*s3ObjectInfo)
+
+	// Create Session
+	svc, err := S3Service(ctx, d, *object.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &s3.GetObjectRetentionInput{
+		Bucket: object.BucketName,
+		Key:    object.Key,
+	}
+
+	retention, err := svc.GetObjectRetentionWithContext(ctx, params)
+	if err != nil {
+		// Objects in buckets without object lock enabled, or without a retention
+		// period set, have no retention configuration
+		if a, ok := err.(awserr.Error); ok {
+			if a.Code() == "NoSuchObjectLockConfiguration" || a.Code() == "InvalidRequest" {
+				return nil, nil
+			}
+		}
+		plugin.Logger(ctx).Error("getS3ObjectRetention", "api_error", err)
+		return nil, err
+	}
+
+	return retention, nil
+}
+
+func getS3ObjectTagging(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getS3ObjectTagging")
+	object := h.Item.(*s3ObjectInfo)
+
+	// Create Session
+	svc, err := S3Service(ctx, d, *object.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &s3.GetObjectTaggingInput{
+		Bucket: object.BucketName,
+		Key:    object.Key,
+	}
+
+	tags, err := svc.GetObjectTaggingWithContext(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("getS3ObjectTagging", "api_error", err)
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+func getS3ObjectARN(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getS3ObjectARN")
+	object := h.Item.(*s3ObjectInfo)
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	c, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	commonColumnData := c.(*awsCommonColumnData)
+	arn := "arn:" + commonColumnData.Partition + ":s3:::" + *object.BucketName + "/" + *object.Key
+
+	return arn, nil
+}
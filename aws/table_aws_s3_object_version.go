@@ -0,0 +1,207 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+// Object versions and delete markers are returned by the same API call as
+// separate lists, so both are merged into a single row type
+type s3ObjectVersionInfo struct {
+	BucketName     *string
+	Region         *string
+	Key            *string
+	VersionId      *string
+	IsLatest       *bool
+	IsDeleteMarker bool
+	Size           *int64
+	StorageClass   *string
+	ETag           *string
+	LastModified   *time.Time
+	Owner          *s3.Owner
+}
+
+//// TABLE DEFINITION
+
+func tableAwsS3ObjectVersion(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_s3_object_version",
+		Description: "AWS S3 Object Version",
+		List: &plugin.ListConfig{
+			Hydrate: listS3ObjectVersions,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "bucket_name", Require: plugin.Required},
+				{Name: "prefix", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"NoSuchBucket"}),
+			},
+		},
+		Columns: awsS3Columns([]*plugin.Column{
+			{
+				Name:        "key",
+				Description: "The object key.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "version_id",
+				Description: "Version ID of the object.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "bucket_name",
+				Description: "The name of the bucket containing the object.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "prefix",
+				Description: "The prefix of the key used to filter the object versions.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("prefix"),
+			},
+			{
+				Name:        "is_latest",
+				Description: "Specifies whether the object is (true) or is not (false) the latest version of an object.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_delete_marker",
+				Description: "Specifies whether the version is a delete marker (true) or an object version (false).",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "size",
+				Description: "Size in bytes of the object version. Null for delete markers.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "storage_class",
+				Description: "The class of storage used to store the object version. Null for delete markers.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "etag",
+				Description: "The entity tag is an MD5 hash of that version of the object.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ETag"),
+			},
+			{
+				Name:        "last_modified",
+				Description: "Date and time the object version was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "owner",
+				Description: "Specifies the owner of the object version.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Key"),
+			},
+			{
+				Name:        "region",
+				Description: "The AWS Region in which the resource is located.",
+				Type:        proto.ColumnType_STRING,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listS3ObjectVersions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listS3ObjectVersions")
+
+	bucketName := d.KeyColumnQuals["bucket_name"].GetStringValue()
+	if bucketName == "" {
+		return nil, nil
+	}
+
+	// Versions can only be listed from the region the bucket resides in
+	location, err := getS3BucketLocationByName(ctx, d, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	region := *location.LocationConstraint
+
+	// Create Session
+	svc, err := S3Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &s3.ListObjectVersionsInput{
+		Bucket:  aws.String(bucketName),
+		MaxKeys: aws.Int64(1000),
+	}
+	if d.KeyColumnQuals["prefix"] != nil {
+		input.Prefix = aws.String(d.KeyColumnQuals["prefix"].GetStringValue())
+	}
+
+	// Limiting the results
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxKeys {
+			if *limit < 1 {
+				input.MaxKeys = aws.Int64(1)
+			} else {
+				input.MaxKeys = limit
+			}
+		}
+	}
+
+	err = svc.ListObjectVersionsPagesWithContext(
+		ctx,
+		input,
+		func(page *s3.ListObjectVersionsOutput, isLast bool) bool {
+			for _, version := range page.Versions {
+				d.StreamListItem(ctx, &s3ObjectVersionInfo{
+					BucketName:   aws.String(bucketName),
+					Region:       aws.String(region),
+					Key:          version.Key,
+					VersionId:    version.VersionId,
+					IsLatest:     version.IsLatest,
+					Size:         version.Size,
+					StorageClass: version.StorageClass,
+					ETag:         version.ETag,
+					LastModified: version.LastModified,
+					Owner:        version.Owner,
+				})
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			for _, marker := range page.DeleteMarkers {
+				d.StreamListItem(ctx, &s3ObjectVersionInfo{
+					BucketName:     aws.String(bucketName),
+					Region:         aws.String(region),
+					Key:            marker.Key,
+					VersionId:      marker.VersionId,
+					IsLatest:       marker.IsLatest,
+					IsDeleteMarker: true,
+					LastModified:   marker.LastModified,
+					Owner:          marker.Owner,
+				})
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
@@ -0,0 +1,288 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSageMakerEndpoint(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_sagemaker_endpoint",
+		Description: "AWS Sagemaker Endpoint",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ValidationException", "NotFoundException"}),
+			},
+			Hydrate: getSagemakerEndpoint,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listSagemakerEndpoints,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "endpoint_status", Require: plugin.Optional},
+				{Name: "creation_time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<="}},
+				{Name: "last_modified_time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<="}},
+			},
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EndpointName"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EndpointArn"),
+			},
+			{
+				Name:        "endpoint_status",
+				Description: "The status of the endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "A timestamp that shows when the endpoint was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "A timestamp that shows when the endpoint was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "endpoint_config_name",
+				Description: "The name of the endpoint configuration associated with this endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSagemakerEndpoint,
+			},
+			{
+				Name:        "failure_reason",
+				Description: "If the status of the endpoint is Failed, the reason why it failed.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSagemakerEndpoint,
+			},
+			{
+				Name:        "async_inference_config",
+				Description: "The configuration for asynchronous inference invocations of the endpoint.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSagemakerEndpoint,
+			},
+			{
+				Name:        "data_capture_config",
+				Description: "The data capture configuration of the endpoint.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSagemakerEndpoint,
+			},
+			{
+				Name:        "last_deployment_config",
+				Description: "The most recent deployment configuration for the endpoint.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSagemakerEndpoint,
+			},
+			{
+				Name:        "pending_deployment_summary",
+				Description: "The summary of an in-progress deployment, when an endpoint is creating or updating with a new endpoint configuration.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSagemakerEndpoint,
+			},
+			{
+				Name:        "production_variants",
+				Description: "An array of ProductionVariantSummary objects, one for each model hosted behind this endpoint.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSagemakerEndpoint,
+			},
+			{
+				Name:        "tags_src",
+				Description: "The list of tags for the endpoint.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listSageMakerEndpointTags,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EndpointName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listSageMakerEndpointTags,
+				Transform:   transform.FromValue().Transform(sageMakerTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("EndpointArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSagemakerEndpoints(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listSagemakerEndpoints")
+
+	// Create Session
+	svc, err := SageMakerService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &sagemaker.ListEndpointsInput{
+		MaxResults: aws.Int64(100),
+	}
+
+	equalQuals := d.KeyColumnQuals
+	if equalQuals["endpoint_status"] != nil {
+		input.StatusEquals = aws.String(equalQuals["endpoint_status"].GetStringValue())
+	}
+
+	quals := d.Quals
+	if quals["creation_time"] != nil {
+		for _, q := range quals["creation_time"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case ">=", ">":
+				input.CreationTimeAfter = aws.Time(timestamp)
+			case "<", "<=":
+				input.CreationTimeBefore = aws.Time(timestamp)
+			}
+		}
+	}
+
+	if quals["last_modified_time"] != nil {
+		for _, q := range quals["last_modified_time"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case ">=", ">":
+				input.LastModifiedTimeAfter = aws.Time(timestamp)
+			case "<", "<=":
+				input.LastModifiedTimeBefore = aws.Time(timestamp)
+			}
+		}
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxResults {
+			if *limit < 1 {
+				input.MaxResults = aws.Int64(1)
+			} else {
+				input.MaxResults = limit
+			}
+		}
+	}
+
+	// List Call
+	err = svc.ListEndpointsPages(
+		input,
+		func(page *sagemaker.ListEndpointsOutput, isLast bool) bool {
+			for _, endpoint := range page.Endpoints {
+				d.StreamListItem(ctx, endpoint)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSagemakerEndpoint(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get endpoint name
+	var endpointName string
+	if h.Item != nil {
+		endpointName = *h.Item.(*sagemaker.EndpointSummary).EndpointName
+	} else {
+		endpointName = d.KeyColumnQuals["name"].GetStringValue()
+	}
+
+	// Create service
+	svc, err := SageMakerService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the params
+	params := &sagemaker.DescribeEndpointInput{
+		EndpointName: aws.String(endpointName),
+	}
+
+	// Get call
+	data, err := svc.DescribeEndpoint(params)
+	if err != nil {
+		plugin.Logger(ctx).Debug("getSagemakerEndpoint", "ERROR", err)
+		return nil, err
+	}
+	return data, nil
+}
+
+func listSageMakerEndpointTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listSageMakerEndpointTags")
+
+	// Create Session
+	svc, err := SageMakerService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	endpointArn := endpointARN(h.Item)
+
+	// Build the params
+	params := &sagemaker.ListTagsInput{
+		ResourceArn: aws.String(endpointArn),
+	}
+
+	pagesLeft := true
+	tags := []*sagemaker.Tag{}
+	for pagesLeft {
+		keyTags, err := svc.ListTags(params)
+		if err != nil {
+			plugin.Logger(ctx).Error("listSageMakerEndpointTags", "ListTags_error", err)
+			return nil, err
+		}
+		tags = append(tags, keyTags.Tags...)
+
+		if keyTags.NextToken != nil {
+			params.NextToken = keyTags.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return tags, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func endpointARN(item interface{}) string {
+	switch item := item.(type) {
+	case *sagemaker.EndpointSummary:
+		return *item.EndpointArn
+	case *sagemaker.DescribeEndpointOutput:
+		return *item.EndpointArn
+	}
+	return ""
+}
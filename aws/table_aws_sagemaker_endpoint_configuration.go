@@ -58,6 +58,7 @@ func tableAwsSageMakerEndpointConfiguration(_ context.Context) *plugin.Table {
 				Name:        "data_capture_config",
 				Description: "Specifies the parameters to capture input/output of Sagemaker models endpoints.",
 				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSagemakerEndpointConfiguration,
 			},
 			{
 				Name:        "production_variants",
@@ -113,8 +114,8 @@ func listSagemakerEndpointConfigurations(ctx context.Context, d *plugin.QueryDat
 	}
 
 	quals := d.Quals
-	if quals["timestamp"] != nil {
-		for _, q := range quals["timestamp"].Quals {
+	if quals["creation_time"] != nil {
+		for _, q := range quals["creation_time"].Quals {
 			timestamp := q.Value.GetTimestampValue().AsTime()
 			switch q.Operator {
 			case ">=", ">":
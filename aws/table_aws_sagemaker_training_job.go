@@ -26,7 +26,7 @@ func tableAwsSageMakerTrainingJob(_ context.Context) *plugin.Table {
 			KeyColumns: []*plugin.KeyColumn{
 				{Name: "creation_time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<="}},
 				{Name: "last_modified_time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<="}},
-				{Name: "training_job_status", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<="}},
+				{Name: "training_job_status", Require: plugin.Optional},
 			},
 		},
 		GetMatrixItem: BuildRegionList,
@@ -0,0 +1,236 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/savingsplans"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSavingsPlan(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_savingsplan",
+		Description: "AWS Savings Plan",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("savings_plan_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getSavingsPlan,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listSavingsPlans,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "state", Require: plugin.Optional},
+			},
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "savings_plan_id",
+				Description: "The ID of the Savings Plan.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the Savings Plan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SavingsPlanArn"),
+			},
+			{
+				Name:        "state",
+				Description: "The state of the Savings Plan, e.g. payment-pending, active, retired or queued.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "savings_plan_type",
+				Description: "The plan type, either Compute, EC2Instance or SageMaker.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "payment_option",
+				Description: "The payment option, either All Upfront, Partial Upfront or No Upfront.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the Savings Plan.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "commitment",
+				Description: "The hourly commitment, in the currency of the Savings Plan.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "upfront_payment_amount",
+				Description: "The up-front payment amount.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "recurring_payment_amount",
+				Description: "The recurring payment amount.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "currency",
+				Description: "The currency of the Savings Plan, either CNY or USD.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "start_time",
+				Description: "The start time of the Savings Plan.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Start"),
+			},
+			{
+				Name:        "end_time",
+				Description: "The end time of the Savings Plan.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("End"),
+			},
+			{
+				Name:        "term_duration_in_seconds",
+				Description: "The duration of the term, in seconds.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "ec2_instance_family",
+				Description: "The EC2 instance family the Savings Plan applies to, for EC2 Instance Savings Plans.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "savings_plan_region",
+				Description: "The AWS Region the Savings Plan applies to, for EC2 Instance Savings Plans.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Region"),
+			},
+			{
+				Name:        "offering_id",
+				Description: "The ID of the offering.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "product_types",
+				Description: "The product types the Savings Plan applies to, e.g. EC2, Fargate, Lambda or SageMaker.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SavingsPlanId"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SavingsPlanArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSavingsPlans(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listSavingsPlans")
+
+	// Create Session
+	svc, err := SavingsPlansService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &savingsplans.DescribeSavingsPlansInput{
+		MaxResults: aws.Int64(1000),
+	}
+
+	if d.KeyColumnQuals["state"] != nil {
+		input.States = []*string{aws.String(d.KeyColumnQuals["state"].GetStringValue())}
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxResults {
+			if *limit < 1 {
+				input.MaxResults = aws.Int64(1)
+			} else {
+				input.MaxResults = limit
+			}
+		}
+	}
+
+	// List call
+	for {
+		op, err := svc.DescribeSavingsPlans(input)
+		if err != nil {
+			plugin.Logger(ctx).Error("listSavingsPlans", "DescribeSavingsPlans_error", err)
+			return nil, err
+		}
+
+		for _, plan := range op.SavingsPlans {
+			d.StreamListItem(ctx, plan)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if op.NextToken == nil {
+			break
+		}
+		input.NextToken = op.NextToken
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSavingsPlan(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getSavingsPlan")
+
+	id := d.KeyColumnQuals["savings_plan_id"].GetStringValue()
+
+	// Empty check
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := SavingsPlansService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &savingsplans.DescribeSavingsPlansInput{
+		SavingsPlanIds: []*string{aws.String(id)},
+	}
+
+	op, err := svc.DescribeSavingsPlans(params)
+	if err != nil {
+		plugin.Logger(ctx).Debug("getSavingsPlan", "ERROR", err)
+		return nil, err
+	}
+
+	if len(op.SavingsPlans) > 0 {
+		return op.SavingsPlans[0], nil
+	}
+
+	return nil, nil
+}
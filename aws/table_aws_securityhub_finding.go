@@ -3,9 +3,11 @@ package aws
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/turbot/go-kit/helpers"
 	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
@@ -32,9 +34,13 @@ func tableAwsSecurityHubFinding(_ context.Context) *plugin.Table {
 				{Name: "product_arn", Require: plugin.Optional, Operators: []string{"=", "<>"}},
 				{Name: "product_name", Require: plugin.Optional, Operators: []string{"=", "<>"}},
 				{Name: "record_state", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+				{Name: "resource_id", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+				{Name: "severity_label", Require: plugin.Optional, Operators: []string{"=", "<>"}},
 				{Name: "title", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+				{Name: "updated_at", Require: plugin.Optional, Operators: []string{"=", ">", ">=", "<", "<="}},
 				{Name: "verification_state", Require: plugin.Optional, Operators: []string{"=", "<>"}},
 				{Name: "workflow_state", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+				{Name: "workflow_status", Require: plugin.Optional, Operators: []string{"=", "<>"}},
 			},
 		},
 		GetMatrixItem: BuildRegionList,
@@ -116,11 +122,23 @@ func tableAwsSecurityHubFinding(_ context.Context) *plugin.Table {
 				Description: "The record state of a finding.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "resource_id",
+				Description: "The identifier of the first resource that the finding refers to, or of the resource matching the resource_id qual.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(extractSecurityHubFindingResourceId),
+			},
 			{
 				Name:        "schema_version",
 				Description: "The schema version that a finding is formatted for.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "severity_label",
+				Description: "The severity label of the finding, e.g. INFORMATIONAL, LOW, MEDIUM, HIGH or CRITICAL.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Severity.Label"),
+			},
 			{
 				Name:        "source_url",
 				Description: "A URL that links to a page about the current finding in the security-findings provider's solution.",
@@ -136,6 +154,12 @@ func tableAwsSecurityHubFinding(_ context.Context) *plugin.Table {
 				Description: "The workflow state of a finding.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "workflow_status",
+				Description: "The status of the investigation into the finding, e.g. NEW, NOTIFIED, RESOLVED or SUPPRESSED.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Workflow.Status"),
+			},
 			{
 				Name:        "standards_control_arn",
 				Description: "The ARN of the security standard control.",
@@ -346,9 +370,8 @@ func getSecurityHubFinding(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 // Build param for findings list call
 func buildListFindingsParam(quals plugin.KeyColumnQualMap) *securityhub.AwsSecurityFindingFilters {
 	securityFindingsFilter := &securityhub.AwsSecurityFindingFilters{}
-	strFilter := &securityhub.StringFilter{}
 
-	strColumns := []string{"company_name", "compliance_status", "generator_id", "product_arn", "product_name", "record_state", "title", "verification_state", "workflow_state"}
+	strColumns := []string{"company_name", "compliance_status", "generator_id", "product_arn", "product_name", "record_state", "resource_id", "severity_label", "title", "verification_state", "workflow_state", "workflow_status"}
 
 	for _, s := range strColumns {
 		if quals[s] == nil {
@@ -360,6 +383,10 @@ func buildListFindingsParam(quals plugin.KeyColumnQualMap) *securityhub.AwsSecur
 				continue
 			}
 
+			// Each qual needs its own filter, otherwise multiple quals on a column overwrite each other
+			strFilter := &securityhub.StringFilter{
+				Value: aws.String(value),
+			}
 			switch q.Operator {
 			case "<>":
 				strFilter.Comparison = aws.String("NOT_EQUALS")
@@ -369,35 +396,79 @@ func buildListFindingsParam(quals plugin.KeyColumnQualMap) *securityhub.AwsSecur
 
 			switch s {
 			case "company_name":
-				strFilter.Value = aws.String(value)
 				securityFindingsFilter.CompanyName = append(securityFindingsFilter.CompanyName, strFilter)
 			case "generator_id":
-				strFilter.Value = aws.String(value)
 				securityFindingsFilter.GeneratorId = append(securityFindingsFilter.GeneratorId, strFilter)
 			case "compliance_status":
-				strFilter.Value = aws.String(value)
 				securityFindingsFilter.ComplianceStatus = append(securityFindingsFilter.ComplianceStatus, strFilter)
 			case "product_arn":
-				strFilter.Value = aws.String(value)
 				securityFindingsFilter.ProductArn = append(securityFindingsFilter.ProductArn, strFilter)
 			case "product_name":
-				strFilter.Value = aws.String(value)
 				securityFindingsFilter.ProductName = append(securityFindingsFilter.ProductName, strFilter)
 			case "record_state":
-				strFilter.Value = aws.String(value)
 				securityFindingsFilter.RecordState = append(securityFindingsFilter.RecordState, strFilter)
+			case "resource_id":
+				securityFindingsFilter.ResourceId = append(securityFindingsFilter.ResourceId, strFilter)
+			case "severity_label":
+				securityFindingsFilter.SeverityLabel = append(securityFindingsFilter.SeverityLabel, strFilter)
 			case "title":
-				strFilter.Value = aws.String(value)
 				securityFindingsFilter.Title = append(securityFindingsFilter.Title, strFilter)
 			case "verification_state":
-				strFilter.Value = aws.String(value)
 				securityFindingsFilter.VerificationState = append(securityFindingsFilter.VerificationState, strFilter)
 			case "workflow_state":
-				strFilter.Value = aws.String(value)
 				securityFindingsFilter.WorkflowState = append(securityFindingsFilter.WorkflowState, strFilter)
+			case "workflow_status":
+				securityFindingsFilter.WorkflowStatus = append(securityFindingsFilter.WorkflowStatus, strFilter)
+			}
+		}
+	}
+
+	numColumns := []string{"confidence", "criticality"}
+
+	for _, n := range numColumns {
+		if quals[n] == nil {
+			continue
+		}
+		for _, q := range quals[n].Quals {
+			value := float64(q.Value.GetInt64Value())
+
+			numFilter := &securityhub.NumberFilter{}
+			switch q.Operator {
+			case "=":
+				numFilter.Eq = aws.Float64(value)
+			case ">=":
+				numFilter.Gte = aws.Float64(value)
+			case "<=":
+				numFilter.Lte = aws.Float64(value)
 			}
 
+			switch n {
+			case "confidence":
+				securityFindingsFilter.Confidence = append(securityFindingsFilter.Confidence, numFilter)
+			case "criticality":
+				securityFindingsFilter.Criticality = append(securityFindingsFilter.Criticality, numFilter)
+			}
+		}
+	}
+
+	// All the updated_at quals are combined into a single date range;
+	// the API has no exclusive bounds, so > and < are widened to >= and <=
+	if quals["updated_at"] != nil {
+		dateFilter := &securityhub.DateFilter{}
+		for _, q := range quals["updated_at"].Quals {
+			// Findings are updated with sub-second timestamps, which RFC3339 would drop
+			value := q.Value.GetTimestampValue().AsTime().Format(time.RFC3339Nano)
+			switch q.Operator {
+			case "=":
+				dateFilter.Start = aws.String(value)
+				dateFilter.End = aws.String(value)
+			case ">", ">=":
+				dateFilter.Start = aws.String(value)
+			case "<", "<=":
+				dateFilter.End = aws.String(value)
+			}
 		}
+		securityFindingsFilter.UpdatedAt = append(securityFindingsFilter.UpdatedAt, dateFilter)
 	}
 
 	return securityFindingsFilter
@@ -414,3 +485,34 @@ func extractStandardControlArn(_ context.Context, d *transform.TransformData) (i
 	}
 	return nil, nil
 }
+
+// extractSecurityHubFindingResourceId returns the resource matched by a
+// resource_id qual, since the ResourceId filter matches any resource of the
+// finding, and the first resource otherwise
+func extractSecurityHubFindingResourceId(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	finding := d.HydrateItem.(*securityhub.AwsSecurityFinding)
+
+	var resourceIds []string
+	for _, q := range d.KeyColumnQuals["resource_id"] {
+		if q.Operator != "=" {
+			continue
+		}
+		if q.Value.GetListValue() != nil {
+			for _, v := range q.Value.GetListValue().Values {
+				resourceIds = append(resourceIds, v.GetStringValue())
+			}
+		} else {
+			resourceIds = append(resourceIds, q.Value.GetStringValue())
+		}
+	}
+	for _, resource := range finding.Resources {
+		if helpers.StringSliceContains(resourceIds, aws.StringValue(resource.Id)) {
+			return resource.Id, nil
+		}
+	}
+
+	if len(finding.Resources) > 0 {
+		return finding.Resources[0].Id, nil
+	}
+	return nil, nil
+}
@@ -3,6 +3,7 @@ package aws
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/securityhub"
 	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
@@ -85,10 +86,13 @@ func listSecurityHubs(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrat
 
 	// List call
 	resp, err := svc.DescribeHub(&securityhub.DescribeHubInput{})
-
 	if err != nil {
+		// Security Hub is not enabled in this region
+		if a, ok := err.(awserr.Error); ok && a.Code() == "InvalidAccessException" {
+			return nil, nil
+		}
 		plugin.Logger(ctx).Error("listSecurityHubs", "query_error", err)
-		return nil, nil
+		return nil, err
 	}
 
 	d.StreamListItem(ctx, resp)
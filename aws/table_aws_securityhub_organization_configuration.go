@@ -0,0 +1,71 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSecurityHubOrganizationConfiguration(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_securityhub_organization_configuration",
+		Description: "AWS Security Hub Organization Configuration",
+		List: &plugin.ListConfig{
+			Hydrate: listSecurityHubOrganizationConfigurations,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "auto_enable",
+				Description: "Indicates whether Security Hub is automatically enabled for the accounts that join the organization.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "member_account_limit_reached",
+				Description: "Indicates whether the maximum number of allowed member accounts are already associated with the Security Hub administrator account.",
+				Type:        proto.ColumnType_BOOL,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "The title of the organization configuration. This is a constant value 'default'",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("default"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSecurityHubOrganizationConfigurations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listSecurityHubOrganizationConfigurations")
+
+	// Create session
+	svc, err := SecurityHubService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := svc.DescribeOrganizationConfiguration(&securityhub.DescribeOrganizationConfigurationInput{})
+	if err != nil {
+		// Security Hub is not enabled in this region, or the account is not the
+		// Security Hub administrator account of the organization
+		if a, ok := err.(awserr.Error); ok && (a.Code() == "InvalidAccessException" || a.Code() == "AccessDeniedException") {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("listSecurityHubOrganizationConfigurations", "DescribeOrganizationConfiguration_error", err)
+		return nil, err
+	}
+
+	d.StreamListItem(ctx, op)
+
+	return nil, nil
+}
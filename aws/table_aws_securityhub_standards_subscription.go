@@ -152,8 +152,8 @@ func GetEnabledStandards(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 			if a.Code() == "InvalidAccessException" {
 				return nil, nil
 			}
-			return nil, err
 		}
+		return nil, err
 	}
 
 	for _, item := range standardsSubscriptions.StandardsSubscriptions {
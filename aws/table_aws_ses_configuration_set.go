@@ -0,0 +1,273 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSESConfigurationSet(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ses_configuration_set",
+		Description: "AWS SES Configuration Set",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"NotFoundException"}),
+			},
+			Hydrate: getSESConfigurationSet,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listSESConfigurationSets,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the configuration set.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSESConfigurationSet,
+				Transform:   transform.FromField("ConfigurationSetName"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the configuration set.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSESConfigurationSetArn,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "tls_policy",
+				Description: "Specifies whether messages that use the configuration set are required to use TLS. If REQUIRE, messages are only delivered if a TLS connection can be established, if OPTIONAL, messages can be delivered in plain text if a TLS connection can't be established.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSESConfigurationSet,
+				Transform:   transform.FromField("DeliveryOptions.TlsPolicy"),
+			},
+			{
+				Name:        "sending_pool_name",
+				Description: "The name of the dedicated IP pool to associate with the configuration set.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSESConfigurationSet,
+				Transform:   transform.FromField("DeliveryOptions.SendingPoolName"),
+			},
+			{
+				Name:        "sending_enabled",
+				Description: "Indicates whether email sending is enabled for the configuration set.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getSESConfigurationSet,
+				Transform:   transform.FromField("SendingOptions.SendingEnabled"),
+			},
+			{
+				Name:        "reputation_metrics_enabled",
+				Description: "Indicates whether tracking of reputation metrics is enabled for the configuration set.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getSESConfigurationSet,
+				Transform:   transform.FromField("ReputationOptions.ReputationMetricsEnabled"),
+			},
+			{
+				Name:        "last_fresh_start",
+				Description: "The date and time when the reputation metrics for the configuration set were last reset.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getSESConfigurationSet,
+				Transform:   transform.FromField("ReputationOptions.LastFreshStart"),
+			},
+			{
+				Name:        "suppressed_reasons",
+				Description: "The reasons that cause email addresses to be automatically added to the suppression list for the configuration set, either BOUNCE or COMPLAINT.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSESConfigurationSet,
+				Transform:   transform.FromField("SuppressionOptions.SuppressedReasons"),
+			},
+			{
+				Name:        "custom_redirect_domain",
+				Description: "The domain to use for tracking open and click events.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSESConfigurationSet,
+				Transform:   transform.FromField("TrackingOptions.CustomRedirectDomain"),
+			},
+			{
+				Name:        "event_destinations",
+				Description: "The event destinations of the configuration set, where email sending events are published.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSESConfigurationSetEventDestinations,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags attached to the configuration set.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSESConfigurationSet,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSESConfigurationSet,
+				Transform:   transform.FromField("ConfigurationSetName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSESConfigurationSet,
+				Transform:   transform.FromField("Tags").Transform(sesV2TurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSESConfigurationSetArn,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSESConfigurationSets(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listSESConfigurationSets")
+
+	// Create Session
+	svc, err := SESV2Service(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &sesv2.ListConfigurationSetsInput{
+		PageSize: aws.Int64(1000),
+	}
+
+	// Limiting the results
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.PageSize {
+			if *limit < 1 {
+				input.PageSize = aws.Int64(1)
+			} else {
+				input.PageSize = limit
+			}
+		}
+	}
+
+	// List call
+	err = svc.ListConfigurationSetsPages(
+		input,
+		func(page *sesv2.ListConfigurationSetsOutput, lastPage bool) bool {
+			for _, name := range page.ConfigurationSets {
+				d.StreamListItem(ctx, *name)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("listSESConfigurationSets", "ListConfigurationSetsPages_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSESConfigurationSet(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getSESConfigurationSet")
+
+	var name string
+	if h.Item != nil {
+		name = sesConfigurationSetName(h.Item)
+	} else {
+		name = d.KeyColumnQuals["name"].GetStringValue()
+	}
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := SESV2Service(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &sesv2.GetConfigurationSetInput{
+		ConfigurationSetName: aws.String(name),
+	}
+
+	op, err := svc.GetConfigurationSet(params)
+	if err != nil {
+		plugin.Logger(ctx).Debug("getSESConfigurationSet", "ERROR", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getSESConfigurationSetEventDestinations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getSESConfigurationSetEventDestinations")
+
+	name := sesConfigurationSetName(h.Item)
+
+	// Create Session
+	svc, err := SESV2Service(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &sesv2.GetConfigurationSetEventDestinationsInput{
+		ConfigurationSetName: aws.String(name),
+	}
+
+	op, err := svc.GetConfigurationSetEventDestinations(params)
+	if err != nil {
+		plugin.Logger(ctx).Error("getSESConfigurationSetEventDestinations", "GetConfigurationSetEventDestinations_error", err)
+		return nil, err
+	}
+
+	return op.EventDestinations, nil
+}
+
+func getSESConfigurationSetArn(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getSESConfigurationSetArn")
+
+	name := sesConfigurationSetName(h.Item)
+	region := d.KeyColumnQualString(matrixKeyRegion)
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	c, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := c.(*awsCommonColumnData)
+
+	arn := "arn:" + commonColumnData.Partition + ":ses:" + region + ":" + commonColumnData.AccountId + ":configuration-set/" + name
+
+	return arn, nil
+}
+
+// sesConfigurationSetName returns the name of the configuration set, the list
+// call streams the names while the get call returns the full configuration set
+func sesConfigurationSetName(item interface{}) string {
+	switch item := item.(type) {
+	case string:
+		return item
+	case *sesv2.GetConfigurationSetOutput:
+		return *item.ConfigurationSetName
+	}
+	return ""
+}
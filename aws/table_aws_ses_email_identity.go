@@ -2,10 +2,11 @@ package aws
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ses"
-	"github.com/turbot/go-kit/types"
+	"github.com/aws/aws-sdk-go/service/sesv2"
 	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
@@ -15,16 +16,33 @@ func tableAwsSESEmailIdentity(_ context.Context) *plugin.Table {
 	return &plugin.Table{
 		Name:        "aws_ses_email_identity",
 		Description: "AWS SES Email Identity",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("identity_name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"NotFoundException"}),
+			},
+			Hydrate: getSESEmailIdentityInfo,
+		},
 		List: &plugin.ListConfig{
 			Hydrate: listSESEmailIdentities,
 		},
 		GetMatrixItem: BuildRegionList,
 		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "identity_name",
+				Description: "The address or domain of the identity.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "identity_type",
+				Description: "The type of the identity, either EMAIL_ADDRESS, DOMAIN or MANAGED_DOMAIN.",
+				Type:        proto.ColumnType_STRING,
+			},
 			{
 				Name:        "identity",
-				Description: "The email identity.",
+				Description: "[DEPRECATED] This column has been deprecated and will be removed in a future release, use identity_name instead. The email identity.",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromValue(),
+				Transform:   transform.FromField("IdentityName"),
 			},
 			{
 				Name:        "arn",
@@ -33,6 +51,17 @@ func tableAwsSESEmailIdentity(_ context.Context) *plugin.Table {
 				Hydrate:     getEmailIdentityARN,
 				Transform:   transform.FromValue(),
 			},
+			{
+				Name:        "sending_enabled",
+				Description: "Indicates whether or not you can send email from the identity. Only available when the identity is listed.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "verified_for_sending_status",
+				Description: "Specifies whether or not the identity is verified. You can only send email from verified email addresses or domains.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getSESEmailIdentity,
+			},
 			{
 				Name:        "verification_status",
 				Description: "The verification status of the identity.",
@@ -45,6 +74,51 @@ func tableAwsSESEmailIdentity(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Hydrate:     getEmailIdentityVerificationAttributes,
 			},
+			{
+				Name:        "dkim_signing_enabled",
+				Description: "Indicates whether DKIM signing is enabled for email sent from the identity.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getSESEmailIdentity,
+				Transform:   transform.FromField("DkimAttributes.SigningEnabled"),
+			},
+			{
+				Name:        "dkim_status",
+				Description: "Describes whether or not Amazon SES has successfully located the DKIM records in the DNS records for the domain, e.g. PENDING, SUCCESS, FAILED, TEMPORARY_FAILURE or NOT_STARTED.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSESEmailIdentity,
+				Transform:   transform.FromField("DkimAttributes.Status"),
+			},
+			{
+				Name:        "dkim_tokens",
+				Description: "The set of unique strings that are used to create a set of CNAME records that you add to the DNS configuration of your domain.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSESEmailIdentity,
+				Transform:   transform.FromField("DkimAttributes.Tokens"),
+			},
+			{
+				Name:        "dkim_attributes",
+				Description: "An object that contains information about the DKIM attributes for the identity.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSESEmailIdentity,
+			},
+			{
+				Name:        "mail_from_attributes",
+				Description: "An object that contains information about the Mail-From attributes for the identity.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSESEmailIdentity,
+			},
+			{
+				Name:        "feedback_forwarding_status",
+				Description: "Indicates whether bounce and complaint notifications are forwarded by email.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getSESEmailIdentity,
+			},
+			{
+				Name:        "configuration_set_name",
+				Description: "The configuration set used by default when sending from this identity.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSESEmailIdentity,
+			},
 			{
 				Name:        "notification_attributes",
 				Description: "Represents the notification attributes of an identity.",
@@ -52,13 +126,41 @@ func tableAwsSESEmailIdentity(_ context.Context) *plugin.Table {
 				Hydrate:     getEmailIdentityNotificationAttributes,
 				Transform:   transform.FromValue(),
 			},
+			{
+				Name:        "policies",
+				Description: "A map of policy names to the sending authorization policies attached to the identity.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSESEmailIdentity,
+				Transform:   transform.FromField("Policies").Transform(sesEmailIdentityPolicies),
+			},
+			{
+				Name:        "policies_std",
+				Description: "A map of policy names to the sending authorization policies attached to the identity, in a canonical form for easier searching.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSESEmailIdentity,
+				Transform:   transform.FromField("Policies").Transform(sesEmailIdentityPoliciesToStd),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags attached to the identity.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSESEmailIdentity,
+				Transform:   transform.FromField("Tags"),
+			},
 
 			// Standard columns for all tables
 			{
 				Name:        "title",
 				Description: resourceInterfaceDescription("title"),
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromValue(),
+				Transform:   transform.FromField("IdentityName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSESEmailIdentity,
+				Transform:   transform.FromField("Tags").Transform(sesV2TurbotTags),
 			},
 			{
 				Name:        "akas",
@@ -76,38 +178,35 @@ func tableAwsSESEmailIdentity(_ context.Context) *plugin.Table {
 func listSESEmailIdentities(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
 	logger := plugin.Logger(ctx)
 	logger.Trace("listSESEmailIdentities")
-	region := d.KeyColumnQualString(matrixKeyRegion)
 
 	// Create Session
-	svc, err := SESService(ctx, d, region)
+	svc, err := SESV2Service(ctx, d)
 	if err != nil {
 		return nil, err
 	}
 
-	// execute list call
-	input := &ses.ListIdentitiesInput{
-		MaxItems:     aws.Int64(1000),
-		IdentityType: &ses.IdentityType_Values()[0],
+	input := &sesv2.ListEmailIdentitiesInput{
+		PageSize: aws.Int64(1000),
 	}
 
 	// Limiting the results
 	limit := d.QueryContext.Limit
 	if d.QueryContext.Limit != nil {
-		if *limit < *input.MaxItems {
+		if *limit < *input.PageSize {
 			if *limit < 1 {
-				input.MaxItems = types.Int64(1)
+				input.PageSize = aws.Int64(1)
 			} else {
-				input.MaxItems = limit
+				input.PageSize = limit
 			}
 		}
 	}
 
 	// List call
-	err = svc.ListIdentitiesPages(
+	err = svc.ListEmailIdentitiesPages(
 		input,
-		func(page *ses.ListIdentitiesOutput, lastPage bool) bool {
-			for _, identity := range page.Identities {
-				d.StreamListItem(ctx, *identity)
+		func(page *sesv2.ListEmailIdentitiesOutput, lastPage bool) bool {
+			for _, identity := range page.EmailIdentities {
+				d.StreamListItem(ctx, identity)
 
 				// Context can be cancelled due to manual cancellation or the limit has been hit
 				if d.QueryStatus.RowsRemaining(ctx) == 0 {
@@ -117,18 +216,71 @@ func listSESEmailIdentities(ctx context.Context, d *plugin.QueryData, _ *plugin.
 			return !lastPage
 		},
 	)
-	return nil, err
+	if err != nil {
+		logger.Error("listSESEmailIdentities", "ListEmailIdentitiesPages_error", err)
+		return nil, err
+	}
+
+	return nil, nil
 }
 
 //// HYDRATE FUNCTIONS
 
+func getSESEmailIdentityInfo(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getSESEmailIdentityInfo")
+
+	name := d.KeyColumnQuals["identity_name"].GetStringValue()
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	op, err := getSESEmailIdentityByName(ctx, d, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sesv2.IdentityInfo{
+		IdentityName: aws.String(name),
+		IdentityType: op.IdentityType,
+	}, nil
+}
+
+func getSESEmailIdentity(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getSESEmailIdentity")
+
+	identity := h.Item.(*sesv2.IdentityInfo)
+
+	return getSESEmailIdentityByName(ctx, d, *identity.IdentityName)
+}
+
+func getSESEmailIdentityByName(ctx context.Context, d *plugin.QueryData, name string) (*sesv2.GetEmailIdentityOutput, error) {
+	// Create Session
+	svc, err := SESV2Service(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &sesv2.GetEmailIdentityInput{
+		EmailIdentity: aws.String(name),
+	}
+
+	op, err := svc.GetEmailIdentity(params)
+	if err != nil {
+		plugin.Logger(ctx).Debug("getSESEmailIdentityByName", "ERROR", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
 func getEmailIdentityVerificationAttributes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	logger := plugin.Logger(ctx)
 	logger.Trace("getEmailIdentityVerificationAttributes")
 
-	identity := h.Item.(string)
+	identity := h.Item.(*sesv2.IdentityInfo).IdentityName
 	region := d.KeyColumnQualString(matrixKeyRegion)
-	identities := []*string{&identity}
 
 	// Create Session
 	svc, err := SESService(ctx, d, region)
@@ -137,22 +289,21 @@ func getEmailIdentityVerificationAttributes(ctx context.Context, d *plugin.Query
 	}
 
 	input := &ses.GetIdentityVerificationAttributesInput{
-		Identities: identities,
+		Identities: []*string{identity},
 	}
 	result, err := svc.GetIdentityVerificationAttributes(input)
 	if err != nil {
 		return nil, err
 	}
-	return result.VerificationAttributes[identity], err
+	return result.VerificationAttributes[*identity], err
 }
 
 func getEmailIdentityNotificationAttributes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	logger := plugin.Logger(ctx)
 	logger.Trace("getEmailIdentityNotificationAttributes")
 
-	identity := h.Item.(string)
+	identity := h.Item.(*sesv2.IdentityInfo).IdentityName
 	region := d.KeyColumnQualString(matrixKeyRegion)
-	identities := []*string{&identity}
 
 	// Create Session
 	svc, err := SESService(ctx, d, region)
@@ -161,19 +312,19 @@ func getEmailIdentityNotificationAttributes(ctx context.Context, d *plugin.Query
 	}
 
 	input := &ses.GetIdentityNotificationAttributesInput{
-		Identities: identities,
+		Identities: []*string{identity},
 	}
 	result, err := svc.GetIdentityNotificationAttributes(input)
 	if err != nil {
 		return nil, err
 	}
-	return result.NotificationAttributes[identity], err
+	return result.NotificationAttributes[*identity], err
 }
 
 func getEmailIdentityARN(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	plugin.Logger(ctx).Trace("getEmailIdentityARN")
 
-	identity := h.Item.(string)
+	identity := h.Item.(*sesv2.IdentityInfo).IdentityName
 	region := d.KeyColumnQualString(matrixKeyRegion)
 
 	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
@@ -182,6 +333,60 @@ func getEmailIdentityARN(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 		return nil, err
 	}
 	commonColumnData := c.(*awsCommonColumnData)
-	arn := "arn:" + commonColumnData.Partition + ":ses:" + region + ":" + commonColumnData.AccountId + ":identity/" + identity
+	arn := "arn:" + commonColumnData.Partition + ":ses:" + region + ":" + commonColumnData.AccountId + ":identity/" + *identity
 	return arn, nil
 }
+
+//// TRANSFORM FUNCTIONS
+
+func sesEmailIdentityPolicies(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	policies, ok := d.Value.(map[string]*string)
+	if !ok || len(policies) == 0 {
+		return nil, nil
+	}
+
+	result := map[string]interface{}{}
+	for name, policy := range policies {
+		var document interface{}
+		if err := json.Unmarshal([]byte(*policy), &document); err != nil {
+			plugin.Logger(ctx).Error("sesEmailIdentityPolicies", "unmarshal_error", err)
+			return nil, err
+		}
+		result[name] = document
+	}
+
+	return result, nil
+}
+
+func sesEmailIdentityPoliciesToStd(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	policies, ok := d.Value.(map[string]*string)
+	if !ok || len(policies) == 0 {
+		return nil, nil
+	}
+
+	result := map[string]interface{}{}
+	for name, policy := range policies {
+		policyStd, err := canonicalPolicy(*policy)
+		if err != nil {
+			plugin.Logger(ctx).Error("sesEmailIdentityPoliciesToStd", "canonicalPolicy_error", err)
+			return nil, err
+		}
+		result[name] = policyStd
+	}
+
+	return result, nil
+}
+
+func sesV2TurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.Value.([]*sesv2.Tag)
+	if !ok || len(tags) == 0 {
+		return nil, nil
+	}
+
+	turbotTagsMap := map[string]string{}
+	for _, i := range tags {
+		turbotTagsMap[*i.Key] = *i.Value
+	}
+
+	return turbotTagsMap, nil
+}
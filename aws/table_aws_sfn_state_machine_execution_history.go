@@ -20,6 +20,9 @@ func tableAwsStepFunctionsStateMachineExecutionHistory(_ context.Context) *plugi
 		List: &plugin.ListConfig{
 			Hydrate:       listStepFunctionsStateMachineExecutionHistories,
 			ParentHydrate: listStepFunctionsStateManchines,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "execution_arn", Require: plugin.Optional},
+			},
 		},
 		GetMatrixItem: BuildRegionList,
 		Columns: awsRegionalColumns([]*plugin.Column{
@@ -244,34 +247,36 @@ func listStepFunctionsStateMachineExecutionHistories(ctx context.Context, d *plu
 	stateMachineArn := h.Item.(*sfn.StateMachineListItem).StateMachineArn
 	var executions []sfn.ExecutionListItem
 
+	// If the execution is given, only fetch its history from the state machine it belongs to
+	executionArn := d.KeyColumnQuals["execution_arn"].GetStringValue()
+	if executionArn != "" {
+		if stepFunctionsExecutionStateMachineArn(executionArn) != *stateMachineArn {
+			return nil, nil
+		}
+		executions = append(executions, sfn.ExecutionListItem{ExecutionArn: types.String(executionArn)})
+	}
+
 	input := &sfn.ListExecutionsInput{
 		MaxResults:      types.Int64(1000),
 		StateMachineArn: stateMachineArn,
 	}
 
-	// If the requested number of items is less than the paging max limit
-	// set the limit to that instead
-	limit := d.QueryContext.Limit
-	if d.QueryContext.Limit != nil {
-		if *limit < *input.MaxResults {
-			input.MaxResults = limit
-		}
-	}
-
 	// List call
-	err = svc.ListExecutionsPages(
-		input,
-		func(page *sfn.ListExecutionsOutput, isLast bool) bool {
-			for _, execution := range page.Executions {
-				executions = append(executions, *execution)
-			}
-			return !isLast
-		},
-	)
+	if executionArn == "" {
+		err = svc.ListExecutionsPages(
+			input,
+			func(page *sfn.ListExecutionsOutput, isLast bool) bool {
+				for _, execution := range page.Executions {
+					executions = append(executions, *execution)
+				}
+				return !isLast
+			},
+		)
 
-	if err != nil {
-		plugin.Logger(ctx).Error("listStepFunctionsStateMachineExecutionHistories", "ListExecutionsPages_error", err)
-		return nil, err
+		if err != nil {
+			plugin.Logger(ctx).Error("listStepFunctionsStateMachineExecutionHistories", "ListExecutionsPages_error", err)
+			return nil, err
+		}
 	}
 
 	var wg sync.WaitGroup
@@ -329,21 +334,38 @@ func getRowDataForExecutionHistory(ctx context.Context, d *plugin.QueryData, arn
 
 	params := &sfn.GetExecutionHistoryInput{
 		ExecutionArn: types.String(arn),
+		MaxResults:   types.Int64(1000),
 	}
 
 	var items []historyInfo
 
-	listHistory, err := svc.GetExecutionHistory(params)
+	err = svc.GetExecutionHistoryPages(
+		params,
+		func(page *sfn.GetExecutionHistoryOutput, isLast bool) bool {
+			for _, event := range page.Events {
+				items = append(items, historyInfo{*event, arn})
+			}
+			return !isLast
+		},
+	)
 	if err != nil {
-		plugin.Logger(ctx).Error("getRowDataForExecutionHistory", "GetExecutionHistory_error", err)
+		plugin.Logger(ctx).Error("getRowDataForExecutionHistory", "GetExecutionHistoryPages_error", err)
 		return nil, err
 	}
 
-	for _, event := range listHistory.Events {
-		items = append(items, historyInfo{*event, arn})
+	return items, nil
+}
+
+// stepFunctionsExecutionStateMachineArn returns the ARN of the state machine an execution belongs to.
+// Execution ARN format is arn:aws:states:us-east-1:632902152528:execution:HelloWorld:a44bc846-3601-fd75-63f7-60ac06a4ef97
+func stepFunctionsExecutionStateMachineArn(executionArn string) string {
+	parts := strings.Split(executionArn, ":")
+	if len(parts) < 8 {
+		return ""
 	}
+	parts[5] = "stateMachine"
 
-	return items, nil
+	return strings.Join(parts[:7], ":")
 }
 
 //// Transform Function
@@ -0,0 +1,208 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsShieldProtection(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_shield_protection",
+		Description: "AWS Shield Protection",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFoundException", "InvalidParameterException", "AccessDeniedException"}),
+			},
+			Hydrate: getShieldProtection,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listShieldProtections,
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the protection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier (ID) of the protection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the protection.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProtectionArn"),
+			},
+			{
+				Name:        "resource_arn",
+				Description: "The ARN of the Amazon Web Services resource that is protected.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "health_check_ids",
+				Description: "The unique identifier (ID) for the Route 53 health checks that are associated with the protection.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "application_layer_automatic_response_configuration",
+				Description: "The automatic application layer DDoS mitigation settings for the protection.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getShieldProtectionTags,
+				Transform:   transform.FromField("Tags").Transform(shieldProtectionTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ProtectionArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listShieldProtections(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listShieldProtections")
+
+	// Create session
+	svc, err := ShieldService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &shield.ListProtectionsInput{
+		MaxResults: aws.Int64(100),
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxResults {
+			if *limit < 1 {
+				input.MaxResults = aws.Int64(1)
+			} else {
+				input.MaxResults = limit
+			}
+		}
+	}
+
+	// List call
+	err = svc.ListProtectionsPages(
+		input,
+		func(page *shield.ListProtectionsOutput, isLast bool) bool {
+			for _, protection := range page.Protections {
+				d.StreamListItem(ctx, protection)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		// The account is not subscribed to Shield Advanced or has no protections
+		if a, ok := err.(awserr.Error); ok {
+			if a.Code() == "ResourceNotFoundException" || a.Code() == "AccessDeniedException" {
+				return nil, nil
+			}
+		}
+		plugin.Logger(ctx).Error("listShieldProtections", "ListProtectionsPages_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getShieldProtection(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getShieldProtection")
+
+	id := d.KeyColumnQuals["id"].GetStringValue()
+
+	// Create session
+	svc, err := ShieldService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &shield.DescribeProtectionInput{
+		ProtectionId: aws.String(id),
+	}
+
+	op, err := svc.DescribeProtection(params)
+	if err != nil {
+		plugin.Logger(ctx).Debug("getShieldProtection", "ERROR", err)
+		return nil, err
+	}
+
+	return op.Protection, nil
+}
+
+func getShieldProtectionTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getShieldProtectionTags")
+
+	protection := h.Item.(*shield.Protection)
+
+	// Create session
+	svc, err := ShieldService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &shield.ListTagsForResourceInput{
+		ResourceARN: protection.ProtectionArn,
+	}
+
+	op, err := svc.ListTagsForResource(params)
+	if err != nil {
+		plugin.Logger(ctx).Debug("getShieldProtectionTags", "ERROR", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func shieldProtectionTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.Value.([]*shield.Tag)
+	if !ok || len(tags) == 0 {
+		return nil, nil
+	}
+
+	turbotTagsMap := map[string]string{}
+	for _, i := range tags {
+		turbotTagsMap[*i.Key] = *i.Value
+	}
+
+	return turbotTagsMap, nil
+}
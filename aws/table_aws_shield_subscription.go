@@ -0,0 +1,110 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsShieldSubscription(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_shield_subscription",
+		Description: "AWS Shield Subscription",
+		List: &plugin.ListConfig{
+			Hydrate: listShieldSubscription,
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "arn",
+				Description: "The ARN (Amazon Resource Name) of the subscription.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SubscriptionArn"),
+			},
+			{
+				Name:        "auto_renew",
+				Description: "Indicates whether the subscription is automatically renewed at the end of the existing term, either ENABLED or DISABLED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "start_time",
+				Description: "The start time of the subscription.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "end_time",
+				Description: "The date and time your subscription will end.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "time_commitment_in_seconds",
+				Description: "The length, in seconds, of the Shield Advanced subscription for the account.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "proactive_engagement_status",
+				Description: "Indicates whether the Shield Response Team (SRT) will use email and phone to notify contacts about escalations to the SRT and to initiate proactive customer support.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "limits",
+				Description: "Specifies how many protections of a given type you can create.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "subscription_limits",
+				Description: "Limits settings for your subscription.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SubscriptionArn"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SubscriptionArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listShieldSubscription(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listShieldSubscription")
+
+	// Create session
+	svc, err := ShieldService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := svc.DescribeSubscription(&shield.DescribeSubscriptionInput{})
+	if err != nil {
+		// The account is not subscribed to Shield Advanced
+		if a, ok := err.(awserr.Error); ok {
+			if a.Code() == "ResourceNotFoundException" || a.Code() == "AccessDeniedException" {
+				return nil, nil
+			}
+		}
+		plugin.Logger(ctx).Error("listShieldSubscription", "DescribeSubscription_error", err)
+		return nil, err
+	}
+
+	if op.Subscription != nil {
+		d.StreamListItem(ctx, op.Subscription)
+	}
+
+	return nil, nil
+}
@@ -28,6 +28,7 @@ func tableAwsSSMDocument(_ context.Context) *plugin.Table {
 			KeyColumns: []*plugin.KeyColumn{
 				{Name: "owner", Require: plugin.Optional},
 				{Name: "document_type", Require: plugin.Optional},
+				{Name: "owner_type", Require: plugin.Optional},
 			},
 		},
 		GetMatrixItem: BuildRegionList,
@@ -43,6 +44,13 @@ func tableAwsSSMDocument(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Hydrate:     getAwsSSMDocumentPermissionDetail,
 			},
+			{
+				Name:        "is_public",
+				Description: "Indicates whether the document is shared publicly with all AWS accounts.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getAwsSSMDocumentPermissionDetail,
+				Transform:   transform.FromField("AccountIds").Transform(ssmDocumentIsPublic),
+			},
 			{
 				Name:        "account_sharing_info_list",
 				Description: "A list of AWS accounts where the current document is shared and the version shared with each account.",
@@ -122,6 +130,19 @@ func tableAwsSSMDocument(_ context.Context) *plugin.Table {
 				Description: "The AWS user account that created the document.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "owner_type",
+				Description: "The type of owner the documents are listed for, either Self, Amazon, Private, Public, ThirdParty or All. Defaults to Self.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("owner_type"),
+			},
+			{
+				Name:        "content",
+				Description: "The contents of the default version of the document.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAwsSSMDocumentContent,
+				Transform:   transform.FromField("Content"),
+			},
 			{
 				Name:        "parameters",
 				Description: "A description of the parameters for a document.",
@@ -234,7 +255,15 @@ func listAwsSSMDocuments(ctx context.Context, d *plugin.QueryData, _ *plugin.Hyd
 		MaxResults: aws.Int64(50),
 	}
 
+	// Only the documents owned by the account are listed by default, as there
+	// are several hundreds of documents shared by Amazon in every region
 	filters := buildSsmDocumentFilter(d.Quals)
+	if d.KeyColumnQuals["owner_type"] == nil && d.KeyColumnQuals["owner"] == nil {
+		filters = append(filters, &ssm.DocumentKeyValuesFilter{
+			Key:    aws.String("Owner"),
+			Values: []*string{aws.String("Self")},
+		})
+	}
 	if len(filters) > 0 {
 		input.Filters = filters
 	}
@@ -337,6 +366,33 @@ func getAwsSSMDocumentPermissionDetail(ctx context.Context, d *plugin.QueryData,
 	return data, nil
 }
 
+func getAwsSSMDocumentContent(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	logger.Trace("getAwsSSMDocumentContent")
+
+	name := documentName(h.Item)
+
+	// Create Session
+	svc, err := SsmService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the params
+	params := &ssm.GetDocumentInput{
+		Name: &name,
+	}
+
+	// Get call
+	data, err := svc.GetDocument(params)
+	if err != nil {
+		logger.Debug("getAwsSSMDocumentContent", "ERROR", err)
+		return nil, err
+	}
+
+	return data, nil
+}
+
 func getAwsSSMDocumentAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	plugin.Logger(ctx).Trace("getAwsSSMDocumentAkas")
 	region := d.KeyColumnQualString(matrixKeyRegion)
@@ -377,6 +433,21 @@ func ssmDocumentTagListToTurbotTags(ctx context.Context, d *transform.TransformD
 	return turbotTagsMap, nil
 }
 
+func ssmDocumentIsPublic(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	accountIds, ok := d.Value.([]*string)
+	if !ok {
+		return false, nil
+	}
+
+	// A document shared publicly has the account ID All in its share permission
+	for _, accountId := range accountIds {
+		if strings.EqualFold(aws.StringValue(accountId), "all") {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func documentName(item interface{}) string {
 	switch item := item.(type) {
 	case *ssm.DocumentDescription:
@@ -405,6 +476,7 @@ func buildSsmDocumentFilter(quals plugin.KeyColumnQualMap) []*ssm.DocumentKeyVal
 
 	filterQuals := map[string]string{
 		"owner":         "Owner",
+		"owner_type":    "Owner",
 		"document_type": "DocumentType",
 	}
 
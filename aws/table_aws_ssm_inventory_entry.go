@@ -0,0 +1,137 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+func tableAwsSSMInventoryEntry(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ssm_inventory_entry",
+		Description: "AWS SSM Inventory Entry",
+		List: &plugin.ListConfig{
+			Hydrate: listAwsSSMInventoryEntries,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "instance_id", Require: plugin.Optional},
+				{Name: "type_name", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "instance_id",
+				Description: "The ID of the managed instance the entry was collected from.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type_name",
+				Description: "The type of inventory item the entry belongs to, e.g. AWS:Application or AWS:Network.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "capture_time",
+				Description: "The time that inventory information was collected for the managed instance.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "schema_version",
+				Description: "The inventory schema version used by the managed instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "entry",
+				Description: "The attribute names and values of the inventory entry.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InstanceId"),
+			},
+		}),
+	}
+}
+
+type InventoryEntryInfo struct {
+	InstanceId    *string
+	TypeName      *string
+	CaptureTime   *string
+	SchemaVersion *string
+	Entry         map[string]*string
+}
+
+//// LIST FUNCTION
+
+func listAwsSSMInventoryEntries(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listAwsSSMInventoryEntries")
+
+	// Create session
+	svc, err := SsmService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &ssm.GetInventoryInput{
+		MaxResults: aws.Int64(50),
+	}
+
+	if d.KeyColumnQuals["instance_id"] != nil {
+		input.Filters = []*ssm.InventoryFilter{
+			{
+				Key:    aws.String("AWS:InstanceInformation.InstanceId"),
+				Type:   aws.String("Equal"),
+				Values: []*string{aws.String(d.KeyColumnQuals["instance_id"].GetStringValue())},
+			},
+		}
+	}
+
+	// Only the AWS:InstanceInformation entries are returned if no type is requested
+	if d.KeyColumnQuals["type_name"] != nil {
+		input.ResultAttributes = []*ssm.ResultAttribute{
+			{
+				TypeName: aws.String(d.KeyColumnQuals["type_name"].GetStringValue()),
+			},
+		}
+	}
+
+	// List call
+	err = svc.GetInventoryPages(
+		input,
+		func(page *ssm.GetInventoryOutput, isLast bool) bool {
+			for _, inventory := range page.Entities {
+				for _, item := range inventory.Data {
+					for _, entry := range item.Content {
+						d.StreamListItem(ctx, &InventoryEntryInfo{
+							InstanceId:    inventory.Id,
+							TypeName:      item.TypeName,
+							CaptureTime:   item.CaptureTime,
+							SchemaVersion: item.SchemaVersion,
+							Entry:         entry,
+						})
+
+						// Context may get cancelled due to manual cancellation or if the limit has been reached
+						if d.QueryStatus.RowsRemaining(ctx) == 0 {
+							return false
+						}
+					}
+				}
+			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("listAwsSSMInventoryEntries", "GetInventoryPages_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
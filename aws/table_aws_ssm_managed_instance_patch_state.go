@@ -0,0 +1,218 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSSMManagedInstancePatchState(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ssm_managed_instance_patch_state",
+		Description: "AWS SSM Managed Instance Patch State",
+		List: &plugin.ListConfig{
+			Hydrate: listSsmManagedInstancePatchStates,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "instance_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "instance_id",
+				Description: "The ID of the managed instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "patch_group",
+				Description: "The name of the patch group the managed instance belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "baseline_id",
+				Description: "The ID of the patch baseline used to patch the instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "operation",
+				Description: "The type of patching operation that was performed, either Scan or Install.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "operation_start_time",
+				Description: "The time the most recent patching operation was started on the instance.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "operation_end_time",
+				Description: "The time the most recent patching operation completed on the instance.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "reboot_option",
+				Description: "Indicates the reboot option specified in the patch baseline, either RebootIfNeeded or NoReboot.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "installed_count",
+				Description: "The number of patches from the patch baseline that are installed on the instance.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "installed_other_count",
+				Description: "The number of patches not specified in the patch baseline that are installed on the instance.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "installed_pending_reboot_count",
+				Description: "The number of patches installed since the last time the instance was rebooted.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "installed_rejected_count",
+				Description: "The number of patches installed on the instance that are specified in the list of rejected patches of the patch baseline.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "missing_count",
+				Description: "The number of patches from the patch baseline that are applicable for the instance but aren't currently installed.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "failed_count",
+				Description: "The number of patches from the patch baseline that were attempted to be installed during the last patching operation, but failed to install.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "not_applicable_count",
+				Description: "The number of patches from the patch baseline that aren't applicable for the instance and therefore aren't installed on the instance.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "unreported_not_applicable_count",
+				Description: "The number of patches beyond the supported limit of not applicable count that aren't reported by name to Inventory.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "critical_non_compliant_count",
+				Description: "The number of instances where patches that are specified as Critical for compliance reporting in the patch baseline aren't installed.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "security_non_compliant_count",
+				Description: "The number of instances where patches that are specified as Security in a patch advisory aren't installed.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "other_non_compliant_count",
+				Description: "The number of instances with patches installed that are specified as other than Critical or Security but aren't compliant with the patch baseline.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "last_no_reboot_install_operation_time",
+				Description: "The time of the last attempt to patch the instance with NoReboot specified as the reboot option.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "install_override_list",
+				Description: "An https URL or an Amazon S3 path-style URL to a list of patches to be installed, overriding the patches specified by the default patch baseline.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "snapshot_id",
+				Description: "The ID of the patch baseline snapshot used during the patching operation.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InstanceId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSsmManagedInstancePatchStates(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listSsmManagedInstancePatchStates")
+
+	// Create session
+	svc, err := SsmService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// DescribeInstancePatchStates requires the instance IDs, so get them from
+	// the managed instances unless one was passed
+	var instanceIds []*string
+	if d.KeyColumnQuals["instance_id"] != nil {
+		instanceIds = []*string{aws.String(d.KeyColumnQuals["instance_id"].GetStringValue())}
+	} else {
+		err = svc.DescribeInstanceInformationPages(
+			&ssm.DescribeInstanceInformationInput{
+				MaxResults: aws.Int64(50),
+			},
+			func(page *ssm.DescribeInstanceInformationOutput, isLast bool) bool {
+				for _, managedInstance := range page.InstanceInformationList {
+					instanceIds = append(instanceIds, managedInstance.InstanceId)
+				}
+				return !isLast
+			},
+		)
+		if err != nil {
+			plugin.Logger(ctx).Error("listSsmManagedInstancePatchStates", "DescribeInstanceInformationPages_error", err)
+			return nil, err
+		}
+	}
+
+	// The patch states can be requested for at most 50 instances at a time
+	for len(instanceIds) > 0 {
+		batchSize := 50
+		if len(instanceIds) < batchSize {
+			batchSize = len(instanceIds)
+		}
+
+		input := &ssm.DescribeInstancePatchStatesInput{
+			InstanceIds: instanceIds[:batchSize],
+			MaxResults:  aws.Int64(50),
+		}
+		instanceIds = instanceIds[batchSize:]
+
+		var stop bool
+		err = svc.DescribeInstancePatchStatesPages(
+			input,
+			func(page *ssm.DescribeInstancePatchStatesOutput, isLast bool) bool {
+				for _, patchState := range page.InstancePatchStates {
+					d.StreamListItem(ctx, patchState)
+
+					// Context may get cancelled due to manual cancellation or if the limit has been reached
+					if d.QueryStatus.RowsRemaining(ctx) == 0 {
+						stop = true
+						return false
+					}
+				}
+				return !isLast
+			},
+		)
+		if err != nil {
+			plugin.Logger(ctx).Error("listSsmManagedInstancePatchStates", "DescribeInstancePatchStatesPages_error", err)
+			return nil, err
+		}
+		if stop {
+			break
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,324 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/synthetics"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSyntheticsCanary(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_synthetics_canary",
+		Description: "AWS CloudWatch Synthetics Canary",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getSyntheticsCanary,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listSyntheticsCanaries,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the canary.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique ID of the canary.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the canary.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSyntheticsCanaryArn,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the canary, e.g. CREATING, READY, STARTING, RUNNING, STOPPED or ERROR.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status.State"),
+			},
+			{
+				Name:        "state_reason",
+				Description: "If the canary has insufficient permissions to run, this field provides more details.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status.StateReason"),
+			},
+			{
+				Name:        "state_reason_code",
+				Description: "If the canary cannot run or has failed, this field displays the reason.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status.StateReasonCode"),
+			},
+			{
+				Name:        "runtime_version",
+				Description: "Specifies the runtime version to use for the canary.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "schedule_expression",
+				Description: "A rate or cron expression that defines how often the canary is to run.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Schedule.Expression"),
+			},
+			{
+				Name:        "schedule_duration_in_seconds",
+				Description: "How long, in seconds, for the canary to continue making regular runs after it was created. 0 means the canary runs indefinitely.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Schedule.DurationInSeconds"),
+			},
+			{
+				Name:        "active_tracing",
+				Description: "Indicates whether X-Ray tracing is enabled for this canary.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("RunConfig.ActiveTracing"),
+			},
+			{
+				Name:        "memory_in_mb",
+				Description: "The maximum amount of memory available to the canary while it is running, in MB.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("RunConfig.MemoryInMB"),
+			},
+			{
+				Name:        "timeout_in_seconds",
+				Description: "How long the canary is allowed to run before it must stop.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("RunConfig.TimeoutInSeconds"),
+			},
+			{
+				Name:        "success_retention_period_in_days",
+				Description: "The number of days to retain data about successful runs of this canary.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "failure_retention_period_in_days",
+				Description: "The number of days to retain data about failed runs of this canary.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "artifact_s3_location",
+				Description: "The location in Amazon S3 where Synthetics stores artifacts from the runs of this canary.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "engine_arn",
+				Description: "The ARN of the Lambda function that is used as your canary's engine.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "execution_role_arn",
+				Description: "The ARN of the IAM role used to run the canary.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "artifact_config",
+				Description: "A structure that contains the configuration for canary artifacts, including the encryption-at-rest settings for artifacts that the canary uploads to Amazon S3.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "code",
+				Description: "A structure that contains information about the canary's Lambda handler and where its code is stored by CloudWatch Synthetics.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "run_config",
+				Description: "A structure that contains information about a canary run.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "schedule",
+				Description: "A structure that contains information about how often the canary is to run, and when these runs are to stop.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "status",
+				Description: "A structure that contains information about the canary's status.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "timeline",
+				Description: "A structure that contains information about when the canary was created, modified, and most recently run.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "visual_reference",
+				Description: "If this canary performs visual monitoring by comparing screenshots, this structure contains the ID of the canary run to use as the baseline for screenshots, and the coordinates of any parts of the screen to ignore during the visual monitoring comparison.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "vpc_config",
+				Description: "If this canary is to test an endpoint in a VPC, this structure contains information about the subnets and security groups of the VPC endpoint.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "last_run",
+				Description: "The most recent run of the canary, including its status and timeline.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSyntheticsCanaryLastRun,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSyntheticsCanaryArn,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSyntheticsCanaries(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listSyntheticsCanaries")
+
+	// Create session
+	svc, err := SyntheticsService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &synthetics.DescribeCanariesInput{
+		MaxResults: aws.Int64(20),
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxResults {
+			if *limit < 1 {
+				input.MaxResults = aws.Int64(1)
+			} else {
+				input.MaxResults = limit
+			}
+		}
+	}
+
+	// List call
+	err = svc.DescribeCanariesPages(
+		input,
+		func(page *synthetics.DescribeCanariesOutput, isLast bool) bool {
+			for _, canary := range page.Canaries {
+				d.StreamListItem(ctx, canary)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("listSyntheticsCanaries", "DescribeCanariesPages_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSyntheticsCanary(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getSyntheticsCanary")
+
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := SyntheticsService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &synthetics.GetCanaryInput{
+		Name: aws.String(name),
+	}
+
+	op, err := svc.GetCanary(params)
+	if err != nil {
+		plugin.Logger(ctx).Debug("getSyntheticsCanary", "ERROR", err)
+		return nil, err
+	}
+
+	return op.Canary, nil
+}
+
+func getSyntheticsCanaryLastRun(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getSyntheticsCanaryLastRun")
+
+	canary := h.Item.(*synthetics.Canary)
+
+	// Create session
+	svc, err := SyntheticsService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// DescribeCanariesLastRun can not be filtered by canary name, so fetch the
+	// first page of the canary's runs, which are returned most recent first
+	params := &synthetics.GetCanaryRunsInput{
+		Name:       canary.Name,
+		MaxResults: aws.Int64(1),
+	}
+
+	op, err := svc.GetCanaryRuns(params)
+	if err != nil {
+		plugin.Logger(ctx).Debug("getSyntheticsCanaryLastRun", "ERROR", err)
+		return nil, err
+	}
+
+	if len(op.CanaryRuns) > 0 {
+		return op.CanaryRuns[0], nil
+	}
+	return nil, nil
+}
+
+func getSyntheticsCanaryArn(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getSyntheticsCanaryArn")
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	canary := h.Item.(*synthetics.Canary)
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	commonColumnData := commonData.(*awsCommonColumnData)
+	arn := "arn:" + commonColumnData.Partition + ":synthetics:" + region + ":" + commonColumnData.AccountId + ":canary:" + *canary.Name
+
+	return arn, nil
+}
@@ -0,0 +1,154 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/support"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsTrustedAdvisorCheckResult(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_trusted_advisor_check_result",
+		Description: "AWS Trusted Advisor Check Result",
+		List: &plugin.ListConfig{
+			Hydrate:    listTrustedAdvisorCheckResults,
+			KeyColumns: plugin.SingleColumn("check_id"),
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "check_id",
+				Description: "The unique identifier of the check.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "check_name",
+				Description: "The display name of the check.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_id",
+				Description: "The unique identifier of the flagged resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource.ResourceId"),
+			},
+			{
+				Name:        "status",
+				Description: "The status code of the flagged resource, either ok, warning or error.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource.Status"),
+			},
+			{
+				Name:        "resource_region",
+				Description: "The AWS Region in which the flagged resource is located.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource.Region"),
+			},
+			{
+				Name:        "is_suppressed",
+				Description: "Indicates whether the resource is excluded from the check by the user.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Resource.IsSuppressed"),
+			},
+			{
+				Name:        "timestamp",
+				Description: "The time of the last refresh of the check.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "metadata",
+				Description: "The details of the flagged resource, keyed by the metadata column headings of the check.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource.ResourceId"),
+			},
+		}),
+	}
+}
+
+type trustedAdvisorCheckResultRow struct {
+	CheckId   *string
+	CheckName *string
+	Timestamp *string
+	Resource  *support.TrustedAdvisorResourceDetail
+	Metadata  map[string]*string
+}
+
+//// LIST FUNCTION
+
+func listTrustedAdvisorCheckResults(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listTrustedAdvisorCheckResults")
+
+	checkId := d.KeyColumnQuals["check_id"].GetStringValue()
+	if checkId == "" {
+		return nil, nil
+	}
+
+	// The metadata of each flagged resource is a list of values in the order of
+	// the metadata column headings of the check
+	checks, err := getTrustedAdvisorChecks(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	var check *support.TrustedAdvisorCheckDescription
+	for _, c := range checks {
+		if *c.Id == checkId {
+			check = c
+			break
+		}
+	}
+	if check == nil {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := SupportService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := svc.DescribeTrustedAdvisorCheckResult(&support.DescribeTrustedAdvisorCheckResultInput{
+		CheckId:  aws.String(checkId),
+		Language: aws.String("en"),
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listTrustedAdvisorCheckResults", "DescribeTrustedAdvisorCheckResult_error", err)
+		return nil, supportSubscriptionError(err)
+	}
+
+	for _, resource := range op.Result.FlaggedResources {
+		metadata := map[string]*string{}
+		for i, value := range resource.Metadata {
+			if i < len(check.Metadata) {
+				metadata[*check.Metadata[i]] = value
+			}
+		}
+
+		d.StreamListItem(ctx, &trustedAdvisorCheckResultRow{
+			CheckId:   check.Id,
+			CheckName: check.Name,
+			Timestamp: op.Result.Timestamp,
+			Resource:  resource,
+			Metadata:  metadata,
+		})
+
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
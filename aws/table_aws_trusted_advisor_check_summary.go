@@ -0,0 +1,217 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/support"
+
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsTrustedAdvisorCheckSummary(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_trusted_advisor_check_summary",
+		Description: "AWS Trusted Advisor Check Summary",
+		List: &plugin.ListConfig{
+			Hydrate: listTrustedAdvisorCheckSummaries,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "id", Require: plugin.Optional},
+				{Name: "category", Require: plugin.Optional},
+			},
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The display name of the check.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Check.Name"),
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier of the check.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Check.Id"),
+			},
+			{
+				Name:        "category",
+				Description: "The category of the check, e.g. cost_optimizing, fault_tolerance, performance, security or service_limits.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Check.Category"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the check.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Check.Description"),
+			},
+			{
+				Name:        "status",
+				Description: "The alert status of the check, either ok, warning, error or not_available.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Summary.Status"),
+			},
+			{
+				Name:        "timestamp",
+				Description: "The time of the last refresh of the check.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Summary.Timestamp"),
+			},
+			{
+				Name:        "has_flagged_resources",
+				Description: "Indicates whether the check flagged any resources.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Summary.HasFlaggedResources"),
+			},
+			{
+				Name:        "resources_flagged",
+				Description: "The number of resources flagged by the check.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Summary.ResourcesSummary.ResourcesFlagged"),
+			},
+			{
+				Name:        "resources_ignored",
+				Description: "The number of resources ignored by the check because of insufficient information.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Summary.ResourcesSummary.ResourcesIgnored"),
+			},
+			{
+				Name:        "resources_processed",
+				Description: "The number of resources analyzed by the check.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Summary.ResourcesSummary.ResourcesProcessed"),
+			},
+			{
+				Name:        "resources_suppressed",
+				Description: "The number of resources excluded from the check by the user.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Summary.ResourcesSummary.ResourcesSuppressed"),
+			},
+			{
+				Name:        "metadata",
+				Description: "The column headings of the metadata of the resources flagged by the check.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Check.Metadata"),
+			},
+			{
+				Name:        "category_specific_summary",
+				Description: "The summary information that relates to the category of the check, e.g. the estimated monthly savings of a cost optimizing check.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Summary.CategorySpecificSummary"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Check.Name"),
+			},
+		}),
+	}
+}
+
+type trustedAdvisorCheckSummary struct {
+	Check   *support.TrustedAdvisorCheckDescription
+	Summary *support.TrustedAdvisorCheckSummary
+}
+
+//// LIST FUNCTION
+
+func listTrustedAdvisorCheckSummaries(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listTrustedAdvisorCheckSummaries")
+
+	checks, err := getTrustedAdvisorChecks(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	id := d.KeyColumnQuals["id"].GetStringValue()
+	category := d.KeyColumnQuals["category"].GetStringValue()
+
+	var checkIds []*string
+	checksById := map[string]*support.TrustedAdvisorCheckDescription{}
+	for _, check := range checks {
+		if (id != "" && *check.Id != id) || (category != "" && *check.Category != category) {
+			continue
+		}
+		checkIds = append(checkIds, check.Id)
+		checksById[*check.Id] = check
+	}
+	if len(checkIds) == 0 {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := SupportService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := svc.DescribeTrustedAdvisorCheckSummaries(&support.DescribeTrustedAdvisorCheckSummariesInput{
+		CheckIds: checkIds,
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listTrustedAdvisorCheckSummaries", "DescribeTrustedAdvisorCheckSummaries_error", err)
+		return nil, supportSubscriptionError(err)
+	}
+
+	for _, summary := range op.Summaries {
+		d.StreamListItem(ctx, &trustedAdvisorCheckSummary{
+			Check:   checksById[*summary.CheckId],
+			Summary: summary,
+		})
+
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+// getTrustedAdvisorChecks returns the catalog of Trusted Advisor checks, which
+// is cached per connection as it rarely changes
+func getTrustedAdvisorChecks(ctx context.Context, d *plugin.QueryData) ([]*support.TrustedAdvisorCheckDescription, error) {
+	cacheKey := "trusted-advisor-checks"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.([]*support.TrustedAdvisorCheckDescription), nil
+	}
+
+	// Create Session
+	svc, err := SupportService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := svc.DescribeTrustedAdvisorChecks(&support.DescribeTrustedAdvisorChecksInput{
+		Language: aws.String("en"),
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("getTrustedAdvisorChecks", "DescribeTrustedAdvisorChecks_error", err)
+		return nil, supportSubscriptionError(err)
+	}
+
+	d.ConnectionManager.Cache.Set(cacheKey, op.Checks)
+	return op.Checks, nil
+}
+
+// supportSubscriptionError explains the error returned by the Support API
+// when the account does not have a support plan that includes the API
+func supportSubscriptionError(err error) error {
+	if a, ok := err.(awserr.Error); ok {
+		if a.Code() == "SubscriptionRequiredException" {
+			return fmt.Errorf("the AWS Support API, and so Trusted Advisor, requires a Business, Enterprise On-Ramp or Enterprise support plan: %v", err)
+		}
+	}
+	return err
+}
@@ -16,8 +16,8 @@ import (
 
 func tableAwsVpcFlowlog(_ context.Context) *plugin.Table {
 	return &plugin.Table{
-		Name:        "aws_vpc_flowlog",
-		Description: "AWS VPC Flowlog",
+		Name:        "aws_vpc_flow_log",
+		Description: "AWS VPC Flow Log",
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("flow_log_id"),
 			IgnoreConfig: &plugin.IgnoreConfig{
@@ -197,6 +197,9 @@ func listVpcFlowlogs(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrate
 			return !lastPage
 		},
 	)
+	if err != nil {
+		plugin.Logger(ctx).Error("listVpcFlowlogs", "DescribeFlowLogsPages", err)
+	}
 
 	return nil, err
 }
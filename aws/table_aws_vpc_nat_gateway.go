@@ -83,6 +83,11 @@ func tableAwsVpcNatGateway(_ context.Context) *plugin.Table {
 				Description: "If the NAT gateway could not be created, specifies the error message for the failure.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "connectivity_type",
+				Description: "Indicates whether the NAT gateway supports public or private connectivity.",
+				Type:        proto.ColumnType_STRING,
+			},
 			{
 				Name:        "provisioned_bandwidth",
 				Description: "Reserved. If you need to sustain traffic greater than the documented limits (https://docs.aws.amazon.com/vpc/latest/userguide/vpc-nat-gateway.html).",
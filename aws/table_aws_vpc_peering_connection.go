@@ -17,6 +17,13 @@ func tableAwsVpcPeeringConnection(_ context.Context) *plugin.Table {
 	return &plugin.Table{
 		Name:        "aws_vpc_peering_connection",
 		Description: "AWS VPC Peering Connection",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"InvalidVpcPeeringConnectionID.NotFound", "InvalidVpcPeeringConnectionId.Malformed"}),
+			},
+			Hydrate: getVpcPeeringConnection,
+		},
 		List: &plugin.ListConfig{
 			Hydrate: listVpcPeeringConnections,
 			KeyColumns: []*plugin.KeyColumn{
@@ -27,6 +34,7 @@ func tableAwsVpcPeeringConnection(_ context.Context) *plugin.Table {
 				{Name: "requester_cidr_block", Require: plugin.Optional},
 				{Name: "requester_owner_id", Require: plugin.Optional},
 				{Name: "requester_vpc_id", Require: plugin.Optional},
+				{Name: "status_code", Require: plugin.Optional},
 				{Name: "status_message", Require: plugin.Optional},
 				{Name: "id", Require: plugin.Optional},
 			},
@@ -236,6 +244,43 @@ func listVpcPeeringConnections(ctx context.Context, d *plugin.QueryData, _ *plug
 	return nil, nil
 }
 
+//// HYDRATE FUNCTIONS
+
+func getVpcPeeringConnection(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getVpcPeeringConnection")
+
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	id := d.KeyColumnQuals["id"].GetStringValue()
+
+	// Empty check
+	if id == "" {
+		return nil, nil
+	}
+
+	// get service
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the params
+	params := &ec2.DescribeVpcPeeringConnectionsInput{
+		VpcPeeringConnectionIds: []*string{aws.String(id)},
+	}
+
+	// Get call
+	op, err := svc.DescribeVpcPeeringConnections(params)
+	if err != nil {
+		plugin.Logger(ctx).Error("getVpcPeeringConnection", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.VpcPeeringConnections) > 0 {
+		return op.VpcPeeringConnections[0], nil
+	}
+	return nil, nil
+}
+
 //// TRANSFORM FUNCTION
 
 func vpcPeeringConnectionTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
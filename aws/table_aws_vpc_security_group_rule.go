@@ -234,13 +234,20 @@ func listSecurityGroupRules(ctx context.Context, d *plugin.QueryData, h *plugin.
 		}
 	}
 
-	groupId := d.KeyColumnQuals["group_id"].GetStringValue()
-	if groupId != "" {
-		paramFilter := &ec2.Filter{
-			Name:   aws.String("group-id"),
-			Values: []*string{aws.String(groupId)},
+	// The group_id qual may be a single value or a list, e.g. group_id in ('sg-1', 'sg-2')
+	if d.KeyColumnQuals["group_id"] != nil {
+		groupIds := []*string{}
+		if groupId := d.KeyColumnQuals["group_id"].GetStringValue(); groupId != "" {
+			groupIds = append(groupIds, aws.String(groupId))
+		} else {
+			groupIds = getListValues(d.KeyColumnQuals["group_id"].GetListValue())
+		}
+		input.Filters = []*ec2.Filter{
+			{
+				Name:   aws.String("group-id"),
+				Values: groupIds,
+			},
 		}
-		input.Filters = []*ec2.Filter{paramFilter}
 	}
 
 	// List call
@@ -29,6 +29,7 @@ func tableAwsVpcSubnet(_ context.Context) *plugin.Table {
 				{Name: "available_ip_address_count", Require: plugin.Optional},
 				{Name: "cidr_block", Require: plugin.Optional},
 				{Name: "default_for_az", Require: plugin.Optional},
+				{Name: "map_public_ip_on_launch", Require: plugin.Optional, Operators: []string{"=", "<>"}},
 				{Name: "outpost_arn", Require: plugin.Optional},
 				{Name: "owner_id", Require: plugin.Optional},
 				{Name: "state", Require: plugin.Optional},
@@ -170,6 +171,7 @@ func listVpcSubnets(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateD
 		{ColumnName: "available_ip_address_count", FilterName: "available-ip-address-count", ColumnType: "int64"},
 		{ColumnName: "cidr_block", FilterName: "cidr-block", ColumnType: "cidr"},
 		{ColumnName: "default_for_az", FilterName: "default-for-az", ColumnType: "boolean"},
+		{ColumnName: "map_public_ip_on_launch", FilterName: "map-public-ip-on-launch", ColumnType: "boolean"},
 		{ColumnName: "outpost_arn", FilterName: "outpost-arn", ColumnType: "string"},
 		{ColumnName: "owner_id", FilterName: "owner-id", ColumnType: "string"},
 		{ColumnName: "state", FilterName: "state", ColumnType: "string"},
@@ -98,7 +98,7 @@ func tableAwsVpcVpnConnection(_ context.Context) *plugin.Table {
 			},
 			{
 				Name:        "vgw_telemetry",
-				Description: "Information about the VPN tunnel.",
+				Description: "Information about the VPN tunnels, including the status of each tunnel (UP or DOWN), its outside IP address and the number of accepted routes.",
 				Type:        proto.ColumnType_JSON,
 			},
 			{
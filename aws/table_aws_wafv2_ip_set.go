@@ -26,6 +26,9 @@ func tableAwsWafv2IpSet(_ context.Context) *plugin.Table {
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listAwsWafv2IpSets,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "scope", Require: plugin.Optional},
+			},
 		},
 		GetMatrixItem: BuildWafRegionList,
 		Columns: []*plugin.Column{
@@ -138,6 +141,11 @@ func listAwsWafv2IpSets(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 	}
 	plugin.Logger(ctx).Trace("listAwsWafv2IpSets", "AWS_REGION", region)
 
+	// Minimize the API call with the given scope
+	if d.KeyColumnQuals["scope"] != nil && d.KeyColumnQuals["scope"].GetStringValue() != *scope {
+		return nil, nil
+	}
+
 	// Create session
 	svc, err := WAFv2Service(ctx, d, region)
 	if err != nil {
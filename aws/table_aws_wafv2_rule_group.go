@@ -26,6 +26,9 @@ func tableAwsWafv2RuleGroup(_ context.Context) *plugin.Table {
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listAwsWafv2RuleGroups,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "scope", Require: plugin.Optional},
+			},
 		},
 		GetMatrixItem: BuildWafRegionList,
 		Columns: []*plugin.Column{
@@ -150,6 +153,11 @@ func listAwsWafv2RuleGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.
 	}
 	plugin.Logger(ctx).Trace("listAwsWafv2RuleGroups", "AWS_REGION", region)
 
+	// Minimize the API call with the given scope
+	if d.KeyColumnQuals["scope"] != nil && d.KeyColumnQuals["scope"].GetStringValue() != *scope {
+		return nil, nil
+	}
+
 	// Create session
 	svc, err := WAFv2Service(ctx, d, region)
 	if err != nil {
@@ -27,6 +27,9 @@ func tableAwsWafv2WebAcl(_ context.Context) *plugin.Table {
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listAwsWafv2WebAcls,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "scope", Require: plugin.Optional},
+			},
 		},
 		GetMatrixItem: BuildWafRegionList,
 		Columns: []*plugin.Column{
@@ -74,6 +77,19 @@ func tableAwsWafv2WebAcl(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_BOOL,
 				Hydrate:     getAwsWafv2WebAcl,
 			},
+			{
+				Name:        "label_namespace",
+				Description: "The label namespace prefix for this web ACL. All labels added by rules in this web ACL have this prefix.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAwsWafv2WebAcl,
+			},
+			{
+				Name:        "associated_resources",
+				Description: "The ARNs of the regional resources (application load balancers, API Gateway stages and AppSync APIs) associated with the web ACL. CloudFront distributions are not included.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listAwsWafv2WebAclAssociatedResources,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "default_action",
 				Description: "The action to perform if none of the Rules contained in the Web ACL match.",
@@ -174,6 +190,11 @@ func listAwsWafv2WebAcls(ctx context.Context, d *plugin.QueryData, _ *plugin.Hyd
 	}
 	plugin.Logger(ctx).Trace("listAwsWafv2WebAcls", "AWS_REGION", region)
 
+	// Minimize the API call with the given scope
+	if d.KeyColumnQuals["scope"] != nil && d.KeyColumnQuals["scope"].GetStringValue() != *scope {
+		return nil, nil
+	}
+
 	// Create session
 	svc, err := WAFv2Service(ctx, d, region)
 	if err != nil {
@@ -366,6 +387,42 @@ func getLoggingConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin
 	return op, nil
 }
 
+func listAwsWafv2WebAclAssociatedResources(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listAwsWafv2WebAclAssociatedResources")
+
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	data := webAclData(h.Item)
+	locationType := strings.Split(strings.Split(string(data["Arn"]), ":")[5], "/")[0]
+
+	// ListResourcesForWebACL only supports regional web ACLs, the CloudFront
+	// distributions of a global web ACL are listed by the CloudFront API
+	if locationType == "global" || region == "global" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := WAFv2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	var resourceArns []*string
+	for _, resourceType := range []string{wafv2.ResourceTypeApplicationLoadBalancer, wafv2.ResourceTypeApiGateway, wafv2.ResourceTypeAppsync} {
+		param := &wafv2.ListResourcesForWebACLInput{
+			WebACLArn:    aws.String(data["Arn"]),
+			ResourceType: aws.String(resourceType),
+		}
+
+		op, err := svc.ListResourcesForWebACL(param)
+		if err != nil {
+			return nil, err
+		}
+		resourceArns = append(resourceArns, op.ResourceArns...)
+	}
+
+	return resourceArns, nil
+}
+
 //// TRANSFORM FUNCTIONS
 
 func webAclLocation(_ context.Context, d *transform.TransformData) (interface{}, error) {
@@ -0,0 +1,280 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/turbot/go-kit/helpers"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsWorkspacesDirectory(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_workspaces_directory",
+		Description: "AWS Workspaces Directory",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("directory_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ValidationException"}),
+			},
+			Hydrate: getWorkspacesDirectory,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listWorkspacesDirectories,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "directory_id",
+				Description: "The directory identifier.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "directory_name",
+				Description: "The name of the directory.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The arn of the directory.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getWorkspacesDirectoryArn,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "alias",
+				Description: "The directory alias.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "directory_type",
+				Description: "The directory type, either SIMPLE_AD or AD_CONNECTOR.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the directory's registration with Amazon WorkSpaces.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "registration_code",
+				Description: "The registration code for the directory. This is the code that users enter in their Amazon WorkSpaces client application to connect to the directory.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "customer_user_name",
+				Description: "The user name for the service account.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "iam_role_id",
+				Description: "The identifier of the IAM role. This is the role that allows Amazon WorkSpaces to make calls to other services on your behalf.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "workspace_security_group_id",
+				Description: "The identifier of the security group that is assigned to new WorkSpaces.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenancy",
+				Description: "Specifies whether the directory is dedicated or shared, either DEDICATED or SHARED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "dns_ip_addresses",
+				Description: "The IP addresses of the DNS servers for the directory.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "ip_group_ids",
+				Description: "The identifiers of the IP access control groups associated with the directory.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "subnet_ids",
+				Description: "The identifiers of the subnets used with the directory.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "self_service_permissions",
+				Description: "The default self-service permissions for WorkSpaces in the directory.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SelfservicePermissions"),
+			},
+			{
+				Name:        "workspace_access_properties",
+				Description: "The devices and operating systems that users can use to access WorkSpaces.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "workspace_creation_properties",
+				Description: "The default creation properties for all WorkSpaces in the directory.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "The list of tags for the directory.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listWorkspacesDirectoryTags,
+				Transform:   transform.FromField("TagList"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DirectoryName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listWorkspacesDirectoryTags,
+				Transform:   transform.From(workspaceTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getWorkspacesDirectoryArn,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listWorkspacesDirectories(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listWorkspacesDirectories")
+	region := d.KeyColumnQualString(matrixKeyRegion)
+
+	// AWS Workspaces is not supported in all regions
+	serviceId := endpoints.WorkspacesServiceID
+	validRegions := SupportedRegionsForService(ctx, d, serviceId)
+	if !helpers.StringSliceContains(validRegions, region) {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := WorkspacesService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &workspaces.DescribeWorkspaceDirectoriesInput{
+		Limit: aws.Int64(25),
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.Limit {
+			if *limit < 1 {
+				input.Limit = aws.Int64(1)
+			} else {
+				input.Limit = limit
+			}
+		}
+	}
+
+	// List call
+	err = svc.DescribeWorkspaceDirectoriesPages(
+		input,
+		func(page *workspaces.DescribeWorkspaceDirectoriesOutput, isLast bool) bool {
+			for _, directory := range page.Directories {
+				d.StreamListItem(ctx, directory)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("listWorkspacesDirectories", "DescribeWorkspaceDirectoriesPages_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getWorkspacesDirectory(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getWorkspacesDirectory")
+	region := d.KeyColumnQualString(matrixKeyRegion)
+
+	// AWS Workspaces is not supported in all regions
+	serviceId := endpoints.WorkspacesServiceID
+	validRegions := SupportedRegionsForService(ctx, d, serviceId)
+	if !helpers.StringSliceContains(validRegions, region) {
+		return nil, nil
+	}
+
+	directoryId := d.KeyColumnQuals["directory_id"].GetStringValue()
+
+	// check if directory id is empty
+	if directoryId == "" {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := WorkspacesService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the params
+	params := &workspaces.DescribeWorkspaceDirectoriesInput{
+		DirectoryIds: aws.StringSlice([]string{directoryId}),
+	}
+
+	// Get call
+	data, err := svc.DescribeWorkspaceDirectories(params)
+	if err != nil {
+		plugin.Logger(ctx).Error("getWorkspacesDirectory", "ERROR", err)
+		return nil, err
+	}
+
+	if len(data.Directories) > 0 {
+		return data.Directories[0], nil
+	}
+
+	return nil, nil
+}
+
+func listWorkspacesDirectoryTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listWorkspacesDirectoryTags")
+
+	directoryId := h.Item.(*workspaces.WorkspaceDirectory).DirectoryId
+
+	return describeWorkspacesResourceTags(ctx, d, directoryId)
+}
+
+func getWorkspacesDirectoryArn(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getWorkspacesDirectoryArn")
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	directoryId := h.Item.(*workspaces.WorkspaceDirectory).DirectoryId
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	commonColumnData := commonData.(*awsCommonColumnData)
+	arn := "arn:" + commonColumnData.Partition + ":workspaces:" + region + ":" + commonColumnData.AccountId + ":directory/" + *directoryId
+
+	return arn, nil
+}
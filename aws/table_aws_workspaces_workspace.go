@@ -113,6 +113,23 @@ func tableAwsWorkspace(_ context.Context) *plugin.Table {
 				Description: "The modification states of the WorkSpace.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "computer_name",
+				Description: "The name of the WorkSpace, as seen by the operating system.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "running_mode",
+				Description: "The running mode of the WorkSpace, either AUTO_STOP or ALWAYS_ON.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("WorkspaceProperties.RunningMode"),
+			},
+			{
+				Name:        "compute_type_name",
+				Description: "The compute type of the WorkSpace, e.g. VALUE, STANDARD or PERFORMANCE.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("WorkspaceProperties.ComputeTypeName"),
+			},
 			{
 				Name:        "workspace_properties",
 				Description: "The properties of the WorkSpace.",
@@ -123,7 +140,7 @@ func tableAwsWorkspace(_ context.Context) *plugin.Table {
 				Description: "The list of tags for the WorkSpace.",
 				Type:        proto.ColumnType_JSON,
 				Hydrate:     listWorkspacesTags,
-				Transform:   transform.FromValue(),
+				Transform:   transform.FromField("TagList"),
 			},
 
 			// Steampipe standard columns
@@ -279,6 +296,11 @@ func listWorkspacesTags(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 
 	workspaceId := h.Item.(*workspaces.Workspace).WorkspaceId
 
+	return describeWorkspacesResourceTags(ctx, d, workspaceId)
+}
+
+// describeWorkspacesResourceTags returns the tags of a WorkSpace or a WorkSpaces directory
+func describeWorkspacesResourceTags(ctx context.Context, d *plugin.QueryData, resourceId *string) (*workspaces.DescribeTagsOutput, error) {
 	// Create Session
 	svc, err := WorkspacesService(ctx, d)
 	if err != nil {
@@ -287,12 +309,12 @@ func listWorkspacesTags(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 
 	// Build the params
 	params := &workspaces.DescribeTagsInput{
-		ResourceId: workspaceId,
+		ResourceId: resourceId,
 	}
 
 	tags, err := svc.DescribeTags(params)
 	if err != nil {
-		plugin.Logger(ctx).Error("listWorkspacesTags", "error", err)
+		plugin.Logger(ctx).Error("describeWorkspacesResourceTags", "error", err)
 		return nil, err
 	}
 
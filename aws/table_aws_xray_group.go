@@ -0,0 +1,130 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/xray"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsXRayGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_xray_group",
+		Description: "AWS X-Ray Group",
+		List: &plugin.ListConfig{
+			Hydrate: listXRayGroups,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "group_name",
+				Description: "The unique case-sensitive name of the group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GroupARN"),
+			},
+			{
+				Name:        "filter_expression",
+				Description: "The filter expression defining the parameters to include traces.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "insights_enabled",
+				Description: "Indicates whether insights are enabled for the group.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("InsightsConfiguration.InsightsEnabled"),
+			},
+			{
+				Name:        "notifications_enabled",
+				Description: "Indicates whether insight notifications are enabled for the group.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("InsightsConfiguration.NotificationsEnabled"),
+			},
+			{
+				Name:        "insights_configuration",
+				Description: "The structure containing configurations related to insights.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags attached to the group.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getXRayGroupTags,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getXRayGroupTags,
+				Transform:   transform.FromValue().Transform(xrayTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GroupName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GroupARN").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listXRayGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listXRayGroups")
+
+	// Create session
+	svc, err := XRayService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// List call
+	err = svc.GetGroupsPages(
+		&xray.GetGroupsInput{},
+		func(page *xray.GetGroupsOutput, isLast bool) bool {
+			for _, group := range page.Groups {
+				d.StreamListItem(ctx, group)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("listXRayGroups", "GetGroupsPages_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getXRayGroupTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getXRayGroupTags")
+
+	arn := h.Item.(*xray.GroupSummary).GroupARN
+
+	return listXRayResourceTags(ctx, d, arn)
+}
@@ -0,0 +1,229 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/xray"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsXRaySamplingRule(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_xray_sampling_rule",
+		Description: "AWS X-Ray Sampling Rule",
+		List: &plugin.ListConfig{
+			Hydrate: listXRaySamplingRules,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "rule_name",
+				Description: "The name of the sampling rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SamplingRule.RuleName"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the sampling rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SamplingRule.RuleARN"),
+			},
+			{
+				Name:        "priority",
+				Description: "The priority of the sampling rule. Rules are evaluated in order of priority, lowest first.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("SamplingRule.Priority"),
+			},
+			{
+				Name:        "fixed_rate",
+				Description: "The percentage of matching requests to instrument, after the reservoir is exhausted.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("SamplingRule.FixedRate"),
+			},
+			{
+				Name:        "reservoir_size",
+				Description: "A fixed number of matching requests to instrument per second, prior to applying the fixed rate.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("SamplingRule.ReservoirSize"),
+			},
+			{
+				Name:        "service_name",
+				Description: "Matches the name that the service uses to identify itself in segments.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SamplingRule.ServiceName"),
+			},
+			{
+				Name:        "service_type",
+				Description: "Matches the origin that the service uses to identify its type in segments.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SamplingRule.ServiceType"),
+			},
+			{
+				Name:        "host",
+				Description: "Matches the hostname from a request URL.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SamplingRule.Host"),
+			},
+			{
+				Name:        "http_method",
+				Description: "Matches the HTTP method of a request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SamplingRule.HTTPMethod"),
+			},
+			{
+				Name:        "url_path",
+				Description: "Matches the path from a request URL.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SamplingRule.URLPath"),
+			},
+			{
+				Name:        "resource_arn",
+				Description: "Matches the ARN of the Amazon Web Services resource on which the service runs.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SamplingRule.ResourceARN"),
+			},
+			{
+				Name:        "version",
+				Description: "The version of the sampling rule format.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("SamplingRule.Version"),
+			},
+			{
+				Name:        "created_at",
+				Description: "When the rule was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "modified_at",
+				Description: "When the rule was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "attributes",
+				Description: "Matches attributes derived from the request.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SamplingRule.Attributes"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags attached to the sampling rule.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getXRaySamplingRuleTags,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getXRaySamplingRuleTags,
+				Transform:   transform.FromValue().Transform(xrayTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SamplingRule.RuleName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SamplingRule.RuleARN").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listXRaySamplingRules(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listXRaySamplingRules")
+
+	// Create session
+	svc, err := XRayService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// List call
+	err = svc.GetSamplingRulesPages(
+		&xray.GetSamplingRulesInput{},
+		func(page *xray.GetSamplingRulesOutput, isLast bool) bool {
+			for _, rule := range page.SamplingRuleRecords {
+				d.StreamListItem(ctx, rule)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("listXRaySamplingRules", "GetSamplingRulesPages_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getXRaySamplingRuleTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getXRaySamplingRuleTags")
+
+	arn := h.Item.(*xray.SamplingRuleRecord).SamplingRule.RuleARN
+
+	return listXRayResourceTags(ctx, d, arn)
+}
+
+func listXRayResourceTags(ctx context.Context, d *plugin.QueryData, arn *string) (interface{}, error) {
+	// Create session
+	svc, err := XRayService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &xray.ListTagsForResourceInput{
+		ResourceARN: arn,
+	}
+
+	var tags []*xray.Tag
+	for {
+		op, err := svc.ListTagsForResource(params)
+		if err != nil {
+			plugin.Logger(ctx).Error("listXRayResourceTags", "ListTagsForResource_error", err)
+			return nil, err
+		}
+		tags = append(tags, op.Tags...)
+		if op.NextToken == nil {
+			break
+		}
+		params.NextToken = op.NextToken
+	}
+
+	return tags, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func xrayTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.Value.([]*xray.Tag)
+	if !ok || len(tags) == 0 {
+		return nil, nil
+	}
+
+	turbotTagsMap := map[string]string{}
+	for _, i := range tags {
+		turbotTagsMap[*i.Key] = *i.Value
+	}
+
+	return turbotTagsMap, nil
+}
@@ -18,6 +18,10 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
 )
 
+// maxInlinePolicyConcurrency bounds the number of inline policies of a single
+// IAM user, role or group that are fetched concurrently
+const maxInlinePolicyConcurrency = 10
+
 func ec2TagsToMap(tags []*ec2.Tag) (*map[string]string, error) {
 	var turbotTagsMap map[string]string
 	if tags == nil {